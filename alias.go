@@ -10,6 +10,11 @@ import (
 	"net/url"
 )
 
+// AliasService adds and removes index aliases. All actions are
+// performed atomically in a single request, e.g. to switch an alias
+// from an old to a new index without downtime.
+// See http://www.elastic.co/guide/en/elasticsearch/reference/current/indices-aliases.html
+// for details.
 type AliasService struct {
 	client  *Client
 	actions []aliasAction
@@ -24,9 +29,10 @@ type aliasAction struct {
 	// Alias name
 	Alias string
 	// Filter
-	Filter *Filter
+	Filter Filter
 }
 
+// NewAliasService creates a new AliasService.
 func NewAliasService(client *Client) *AliasService {
 	builder := &AliasService{
 		client:  client,
@@ -40,35 +46,31 @@ func (s *AliasService) Pretty(pretty bool) *AliasService {
 	return s
 }
 
+// Add adds an action that points aliasName to indexName.
 func (s *AliasService) Add(indexName string, aliasName string) *AliasService {
 	action := aliasAction{Type: "add", Index: indexName, Alias: aliasName}
 	s.actions = append(s.actions, action)
 	return s
 }
 
-func (s *AliasService) AddWithFilter(indexName string, aliasName string, filter *Filter) *AliasService {
+// AddWithFilter adds an action that points aliasName to indexName,
+// restricted to the documents that match filter. As filters and queries
+// share the same interface, you can pass a query as well.
+func (s *AliasService) AddWithFilter(indexName string, aliasName string, filter Filter) *AliasService {
 	action := aliasAction{Type: "add", Index: indexName, Alias: aliasName, Filter: filter}
 	s.actions = append(s.actions, action)
 	return s
 }
 
+// Remove adds an action that removes aliasName from indexName.
 func (s *AliasService) Remove(indexName string, aliasName string) *AliasService {
 	action := aliasAction{Type: "remove", Index: indexName, Alias: aliasName}
 	s.actions = append(s.actions, action)
 	return s
 }
 
-func (s *AliasService) Do() (*AliasResult, error) {
-	// Build url
-	path := "/_aliases"
-
-	// Parameters
-	params := make(url.Values)
-	if s.pretty {
-		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
-	}
-
-	// Actions
+// body returns the body of the request, i.e. { "actions" : [ ... ] }.
+func (s *AliasService) body() interface{} {
 	body := make(map[string]interface{})
 	actionsJson := make([]interface{}, 0)
 
@@ -78,13 +80,29 @@ func (s *AliasService) Do() (*AliasResult, error) {
 		detailsJson["index"] = action.Index
 		detailsJson["alias"] = action.Alias
 		if action.Filter != nil {
-			detailsJson["filter"] = (*action.Filter).Source()
+			detailsJson["filter"] = action.Filter.Source()
 		}
 		actionJson[action.Type] = detailsJson
 		actionsJson = append(actionsJson, actionJson)
 	}
 
 	body["actions"] = actionsJson
+	return body
+}
+
+// Do executes the actions.
+func (s *AliasService) Do() (*AliasResult, error) {
+	// Build url
+	path := "/_aliases"
+
+	// Parameters
+	params := make(url.Values)
+	if s.pretty {
+		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
+	}
+
+	// Actions
+	body := s.body()
 
 	// Get response
 	res, err := s.client.PerformRequest("POST", path, params, body)
@@ -102,6 +120,7 @@ func (s *AliasService) Do() (*AliasResult, error) {
 
 // -- Result of an alias request.
 
+// AliasResult is the outcome of AliasService.Do.
 type AliasResult struct {
 	Acknowledged bool `json:"acknowledged"`
 }
@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"testing"
 )
 
@@ -121,3 +122,19 @@ func TestAliasLifecycle(t *testing.T) {
 	}
 
 }
+
+func TestAliasSwitchWithFilter(t *testing.T) {
+	svc := NewAliasService(nil).
+		Remove("tweets-1", "tweets").
+		AddWithFilter("tweets-2", "tweets", NewTermQuery("user", "olivere")).
+		Add("tweets-2", "tweets-all")
+	data, err := json.Marshal(svc.body())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"actions":[{"remove":{"alias":"tweets","index":"tweets-1"}},{"add":{"alias":"tweets","filter":{"term":{"user":"olivere"}},"index":"tweets-2"}},{"add":{"alias":"tweets-all","index":"tweets-2"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
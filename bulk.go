@@ -14,6 +14,12 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// BulkService allows to send many index, update, and delete requests
+// to Elasticsearch in a single roundtrip. Add requests with Add and
+// commit them with Do. The service is reset after a successful Do,
+// so it can be reused for the next batch of requests.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-bulk.html
+// for details.
 type BulkService struct {
 	client *Client
 
@@ -35,7 +41,8 @@ func NewBulkService(client *Client) *BulkService {
 	return builder
 }
 
-func (s *BulkService) reset() {
+// Reset removes all requests that have been added to the service.
+func (s *BulkService) Reset() {
 	s.requests = make([]BulkableRequest, 0)
 }
 
@@ -64,11 +71,15 @@ func (s *BulkService) Pretty(pretty bool) *BulkService {
 	return s
 }
 
+// Add adds a bulkable request, e.g. a BulkIndexRequest,
+// a BulkUpdateRequest, or a BulkDeleteRequest.
 func (s *BulkService) Add(r BulkableRequest) *BulkService {
 	s.requests = append(s.requests, r)
 	return s
 }
 
+// NumberOfActions returns the number of requests that
+// will be committed on the next call to Do.
 func (s *BulkService) NumberOfActions() int {
 	return len(s.requests)
 }
@@ -84,7 +95,7 @@ func (s *BulkService) bodyAsString() (string, error) {
 		for _, line := range source {
 			_, err := buf.WriteString(fmt.Sprintf("%s\n", line))
 			if err != nil {
-				return "", nil
+				return "", err
 			}
 		}
 	}
@@ -151,7 +162,7 @@ func (s *BulkService) Do() (*BulkResponse, error) {
 	}
 
 	// Reset so the request can be reused
-	s.reset()
+	s.Reset()
 
 	return ret, nil
 }
@@ -0,0 +1,378 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	// DefaultBulkProcessorActions is the number of requests after which
+	// a BulkProcessor commits by default.
+	DefaultBulkProcessorActions = 1000
+
+	// DefaultBulkProcessorSize is the estimated size of all requests in
+	// bytes after which a BulkProcessor commits by default (5 MB).
+	DefaultBulkProcessorSize = 5 << 20
+)
+
+var (
+	// ErrBulkProcessorClosed is returned when adding a request to a
+	// BulkProcessor that is not running.
+	ErrBulkProcessorClosed = errors.New("elastic: bulk processor is closed")
+)
+
+// BulkAfterFunc is called after a BulkProcessor committed a batch of
+// requests. The executionId is unique for every commit of the processor.
+// The requests are the ones sent to Elasticsearch. Either response is
+// the response of the bulk operation, or err is the error that occurred.
+// Notice that a successful response may still contain failed items,
+// see BulkResponse.Failed.
+//
+// The callback runs on the worker that committed the requests, so it
+// must not call Add, Flush, or Close of the processor: the worker can't
+// accept new requests or flush before the callback returns, and the
+// call blocks forever.
+type BulkAfterFunc func(executionId int64, requests []BulkableRequest, response *BulkResponse, err error)
+
+// BulkProcessorService sets up a BulkProcessor. Create one with
+// client.BulkProcessor(), configure it, then call Do to start
+// the processor.
+//
+// Example:
+//
+//   p, err := client.BulkProcessor().
+//     Workers(2).
+//     BulkActions(1000).
+//     FlushInterval(30 * time.Second).
+//     After(func(id int64, reqs []elastic.BulkableRequest, res *elastic.BulkResponse, err error) {
+//       // Inspect results
+//     }).
+//     Do()
+//   if err != nil {
+//     // Handle error
+//   }
+//   err = p.Add(elastic.NewBulkIndexRequest().Index("twitter").Type("tweet").Id("1").Doc(tweet))
+//   ...
+//   // Commit all outstanding requests and stop the processor
+//   p.Close()
+//
+type BulkProcessorService struct {
+	client        *Client
+	afterFn       BulkAfterFunc
+	numWorkers    int
+	bulkActions   int
+	bulkSize      int
+	flushInterval time.Duration
+}
+
+// NewBulkProcessorService creates a new BulkProcessorService.
+func NewBulkProcessorService(client *Client) *BulkProcessorService {
+	return &BulkProcessorService{
+		client:      client,
+		numWorkers:  1,
+		bulkActions: DefaultBulkProcessorActions,
+		bulkSize:    DefaultBulkProcessorSize,
+	}
+}
+
+// Workers sets the number of workers that commit requests concurrently.
+// The default is 1.
+func (s *BulkProcessorService) Workers(num int) *BulkProcessorService {
+	s.numWorkers = num
+	return s
+}
+
+// BulkActions sets the number of requests after which a worker commits.
+// Use -1 to disable it. The default is DefaultBulkProcessorActions.
+func (s *BulkProcessorService) BulkActions(bulkActions int) *BulkProcessorService {
+	s.bulkActions = bulkActions
+	return s
+}
+
+// BulkSize sets the estimated size of requests in bytes after which
+// a worker commits. Use -1 to disable it. The default is
+// DefaultBulkProcessorSize.
+func (s *BulkProcessorService) BulkSize(bulkSize int) *BulkProcessorService {
+	s.bulkSize = bulkSize
+	return s
+}
+
+// FlushInterval sets the interval after which all outstanding requests
+// are committed, regardless of BulkActions and BulkSize. It is disabled
+// by default.
+func (s *BulkProcessorService) FlushInterval(interval time.Duration) *BulkProcessorService {
+	s.flushInterval = interval
+	return s
+}
+
+// After sets a callback that is invoked after every commit.
+func (s *BulkProcessorService) After(fn BulkAfterFunc) *BulkProcessorService {
+	s.afterFn = fn
+	return s
+}
+
+// Do creates and starts a new BulkProcessor.
+func (s *BulkProcessorService) Do() (*BulkProcessor, error) {
+	p := newBulkProcessor(
+		s.client,
+		s.numWorkers,
+		s.bulkActions,
+		s.bulkSize,
+		s.flushInterval,
+		s.afterFn)
+	if err := p.Start(); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// -- Bulk Processor --
+
+// BulkProcessor buffers bulkable requests and commits them to
+// Elasticsearch in the background. A worker commits its requests when
+// the number of requests or their estimated size exceed the configured
+// thresholds, when the flush interval elapses, or when Flush or Close
+// is called.
+//
+// Use BulkProcessorService (by calling client.BulkProcessor())
+// to create a new BulkProcessor.
+type BulkProcessor struct {
+	client        *Client
+	afterFn       BulkAfterFunc
+	numWorkers    int
+	bulkActions   int
+	bulkSize      int
+	flushInterval time.Duration
+
+	executionId  int64 // updated atomically
+	requestsC    chan BulkableRequest
+	workerWg     sync.WaitGroup
+	workers      []*bulkWorker
+	flusherStopC chan struct{}
+
+	mu      sync.RWMutex // guards the following block
+	started bool
+}
+
+func newBulkProcessor(
+	client *Client,
+	numWorkers int,
+	bulkActions int,
+	bulkSize int,
+	flushInterval time.Duration,
+	afterFn BulkAfterFunc) *BulkProcessor {
+	return &BulkProcessor{
+		client:        client,
+		numWorkers:    numWorkers,
+		bulkActions:   bulkActions,
+		bulkSize:      bulkSize,
+		flushInterval: flushInterval,
+		afterFn:       afterFn,
+	}
+}
+
+// Start starts the workers of the processor. You don't need to call
+// Start when the processor was created with BulkProcessorService.Do,
+// but you may restart a processor after Close.
+//
+// If the processor is already running, this is a no-op.
+func (p *BulkProcessor) Start() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.started {
+		return nil
+	}
+
+	if p.numWorkers < 1 {
+		p.numWorkers = 1
+	}
+
+	p.requestsC = make(chan BulkableRequest)
+	p.workers = make([]*bulkWorker, p.numWorkers)
+	for i := 0; i < p.numWorkers; i++ {
+		p.workerWg.Add(1)
+		p.workers[i] = newBulkWorker(p)
+		go p.workers[i].work()
+	}
+
+	if p.flushInterval > 0 {
+		p.flusherStopC = make(chan struct{})
+		go p.flusher(p.flushInterval, p.flusherStopC)
+	}
+
+	p.started = true
+	return nil
+}
+
+// Close commits all outstanding requests and stops the workers.
+// Add returns ErrBulkProcessorClosed after Close (unless the processor
+// is restarted).
+//
+// If the processor is not running, this is a no-op.
+func (p *BulkProcessor) Close() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.started {
+		return nil
+	}
+
+	// Stop the flusher (it doesn't flush any more as started is false
+	// once we're done here)
+	if p.flusherStopC != nil {
+		close(p.flusherStopC)
+		p.flusherStopC = nil
+	}
+
+	// Workers commit outstanding requests and stop when the channel is closed
+	close(p.requestsC)
+	p.workerWg.Wait()
+
+	p.started = false
+	return nil
+}
+
+// Add adds a single request to the processor. It is committed by
+// one of the workers eventually. Add returns ErrBulkProcessorClosed
+// if the processor is not running.
+func (p *BulkProcessor) Add(request BulkableRequest) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if !p.started {
+		return ErrBulkProcessorClosed
+	}
+	p.requestsC <- request
+	return nil
+}
+
+// Flush makes all workers commit their outstanding requests.
+// It blocks until all workers are done. Flush must not be called
+// from the After callback, see BulkAfterFunc.
+func (p *BulkProcessor) Flush() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if !p.started {
+		return nil
+	}
+
+	for _, w := range p.workers {
+		w.flushC <- struct{}{}
+		<-w.flushAckC
+	}
+	return nil
+}
+
+// flusher periodically flushes all workers. It stops when stopC is closed.
+func (p *BulkProcessor) flusher(interval time.Duration, stopC chan struct{}) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			p.Flush()
+		case <-stopC:
+			return
+		}
+	}
+}
+
+// -- Bulk Worker --
+
+// bulkWorker collects requests in its own BulkService
+// and commits them when required.
+type bulkWorker struct {
+	p           *BulkProcessor
+	service     *BulkService
+	sizeInBytes int
+	flushC      chan struct{}
+	flushAckC   chan struct{}
+}
+
+func newBulkWorker(p *BulkProcessor) *bulkWorker {
+	return &bulkWorker{
+		p:         p,
+		service:   NewBulkService(p.client),
+		flushC:    make(chan struct{}),
+		flushAckC: make(chan struct{}),
+	}
+}
+
+// work runs until the requests channel of the processor is closed.
+func (w *bulkWorker) work() {
+	defer w.p.workerWg.Done()
+
+	for {
+		select {
+		case req, open := <-w.p.requestsC:
+			if !open {
+				// Commit outstanding requests before we stop
+				if w.service.NumberOfActions() > 0 {
+					w.commit()
+				}
+				return
+			}
+			w.add(req)
+			if w.commitRequired() {
+				w.commit()
+			}
+		case <-w.flushC:
+			if w.service.NumberOfActions() > 0 {
+				w.commit()
+			}
+			w.flushAckC <- struct{}{}
+		}
+	}
+}
+
+// add adds a request to the worker and keeps track of the
+// estimated size of all requests.
+func (w *bulkWorker) add(req BulkableRequest) {
+	w.service.Add(req)
+	if lines, err := req.Source(); err == nil {
+		for _, line := range lines {
+			w.sizeInBytes += len(line) + 1 // +1 for the newline
+		}
+	}
+}
+
+// commitRequired returns true if the number of requests or their
+// estimated size exceeds the thresholds of the processor.
+func (w *bulkWorker) commitRequired() bool {
+	if w.p.bulkActions >= 0 && w.service.NumberOfActions() >= w.p.bulkActions {
+		return true
+	}
+	if w.p.bulkSize >= 0 && w.sizeInBytes >= w.p.bulkSize {
+		return true
+	}
+	return false
+}
+
+// commit sends all requests of the worker to Elasticsearch and
+// reports the outcome to the callback of the processor.
+func (w *bulkWorker) commit() {
+	id := atomic.AddInt64(&w.p.executionId, 1)
+	reqs := w.service.requests
+
+	res, err := w.service.Do()
+	if err != nil {
+		// Do doesn't reset the service on failure, so we do it here
+		// in order not to commit the failed requests over and over again
+		w.p.client.errorf("elastic: bulk processor failed to commit %d requests: %v", len(reqs), err)
+		w.service.Reset()
+	}
+	w.sizeInBytes = 0
+
+	if w.p.afterFn != nil {
+		w.p.afterFn(id, reqs, res, err)
+	}
+}
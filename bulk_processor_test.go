@@ -0,0 +1,144 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestBulkProcessorCommitOnBulkActions(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	var mu sync.Mutex
+	var numCommits, numRequests int
+	after := func(id int64, reqs []BulkableRequest, res *BulkResponse, err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if err != nil {
+			t.Errorf("expected no error; got: %v", err)
+		}
+		numCommits += 1
+		numRequests += len(reqs)
+	}
+
+	p, err := client.BulkProcessor().Workers(2).BulkActions(10).BulkSize(-1).After(after).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	numDocs := 95
+	for i := 1; i <= numDocs; i++ {
+		tw := tweet{User: "olivere", Message: fmt.Sprintf("Tweet number %d.", i)}
+		p.Add(NewBulkIndexRequest().Index(testIndexName).Type("tweet").Id(fmt.Sprintf("%d", i)).Doc(tw))
+	}
+
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	mu.Lock()
+	if numRequests != numDocs {
+		t.Errorf("expected %d committed requests; got: %d", numDocs, numRequests)
+	}
+	if numCommits < numDocs/10 {
+		t.Errorf("expected at least %d commits; got: %d", numDocs/10, numCommits)
+	}
+	mu.Unlock()
+
+	_, err = client.Flush().Index(testIndexName).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, err := client.Count(testIndexName).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != int64(numDocs) {
+		t.Errorf("expected %d documents; got: %d", numDocs, count)
+	}
+}
+
+func TestBulkProcessorCommitOnFlushInterval(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	committed := make(chan int, 1)
+	after := func(id int64, reqs []BulkableRequest, res *BulkResponse, err error) {
+		committed <- len(reqs)
+	}
+
+	p, err := client.BulkProcessor().BulkActions(-1).BulkSize(-1).FlushInterval(100 * time.Millisecond).After(after).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	tw := tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."}
+	p.Add(NewBulkIndexRequest().Index(testIndexName).Type("tweet").Id("1").Doc(tw))
+
+	select {
+	case n := <-committed:
+		if n != 1 {
+			t.Errorf("expected %d committed requests; got: %d", 1, n)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected flush interval to commit requests")
+	}
+}
+
+func TestBulkProcessorFlush(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	var numRequests int
+	after := func(id int64, reqs []BulkableRequest, res *BulkResponse, err error) {
+		numRequests += len(reqs)
+	}
+
+	p, err := client.BulkProcessor().BulkActions(-1).BulkSize(-1).After(after).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer p.Close()
+
+	tw := tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."}
+	p.Add(NewBulkIndexRequest().Index(testIndexName).Type("tweet").Id("1").Doc(tw))
+	p.Add(NewBulkDeleteRequest().Index(testIndexName).Type("tweet").Id("1"))
+
+	if err := p.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	if numRequests != 2 {
+		t.Errorf("expected %d committed requests; got: %d", 2, numRequests)
+	}
+}
+
+func TestBulkProcessorAddAfterClose(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p, err := client.BulkProcessor().Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	err = p.Add(NewBulkDeleteRequest().Index(testIndexName).Type("tweet").Id("1"))
+	if err != ErrBulkProcessorClosed {
+		t.Errorf("expected %v; got: %v", ErrBulkProcessorClosed, err)
+	}
+}
@@ -368,3 +368,22 @@ func TestFailedBulkRequests(t *testing.T) {
 		t.Errorf("expected %d failed items; got: %d", 2, len(failed))
 	}
 }
+
+func TestBulkReset(t *testing.T) {
+	index1Req := NewBulkIndexRequest().Index(testIndexName).Type("tweet").Id("1").Doc(tweet{User: "olivere", Message: "Welcome to Golang and Elasticsearch."})
+	delete1Req := NewBulkDeleteRequest().Index(testIndexName).Type("tweet").Id("1")
+
+	bulkRequest := NewBulkService(nil)
+	bulkRequest = bulkRequest.Add(index1Req)
+	bulkRequest = bulkRequest.Add(delete1Req)
+
+	if bulkRequest.NumberOfActions() != 2 {
+		t.Errorf("expected bulkRequest.NumberOfActions %d; got %d", 2, bulkRequest.NumberOfActions())
+	}
+
+	bulkRequest.Reset()
+
+	if bulkRequest.NumberOfActions() != 0 {
+		t.Errorf("expected bulkRequest.NumberOfActions %d; got %d", 0, bulkRequest.NumberOfActions())
+	}
+}
@@ -0,0 +1,133 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// CatHealthService returns a terse, one-line representation of the
+// health of the cluster. The cat API is requested in JSON format.
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/cat-health.html.
+type CatHealthService struct {
+	client        *Client
+	pretty        bool
+	local         *bool
+	masterTimeout string
+	columns       []string
+	ts            *bool
+}
+
+// NewCatHealthService creates a new CatHealthService.
+func NewCatHealthService(client *Client) *CatHealthService {
+	return &CatHealthService{
+		client: client,
+	}
+}
+
+// Local indicates to return local information, i.e. do not retrieve
+// the state from the master node (default: false).
+func (s *CatHealthService) Local(local bool) *CatHealthService {
+	s.local = &local
+	return s
+}
+
+// MasterTimeout is the explicit operation timeout for connection to master node.
+func (s *CatHealthService) MasterTimeout(masterTimeout string) *CatHealthService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Columns restricts the returned columns, e.g. "cluster" and "status".
+// All other fields of the rows are empty then.
+func (s *CatHealthService) Columns(columns ...string) *CatHealthService {
+	s.columns = append(s.columns, columns...)
+	return s
+}
+
+// Timestamp indicates whether to return the epoch and timestamp
+// columns (default: true).
+func (s *CatHealthService) Timestamp(ts bool) *CatHealthService {
+	s.ts = &ts
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *CatHealthService) Pretty(pretty bool) *CatHealthService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *CatHealthService) buildURL() (string, url.Values, error) {
+	path := "/_cat/health"
+
+	// Add query string parameters
+	params := url.Values{
+		"format": []string{"json"}, // always returns as JSON
+	}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.local != nil {
+		params.Set("local", fmt.Sprintf("%v", *s.local))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if len(s.columns) > 0 {
+		params.Set("h", strings.Join(s.columns, ","))
+	}
+	if s.ts != nil {
+		params.Set("ts", fmt.Sprintf("%v", *s.ts))
+	}
+	return path, params, nil
+}
+
+// Do executes the operation.
+func (s *CatHealthService) Do() (CatHealthResponse, error) {
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest("GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	var ret CatHealthResponse
+	if err := json.Unmarshal(res.Body, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CatHealthResponse is the response of CatHealthService.Do.
+type CatHealthResponse []CatHealthResponseRow
+
+// CatHealthResponseRow is a single row of the CatHealthResponse.
+// Elasticsearch returns all values as strings.
+type CatHealthResponseRow struct {
+	Epoch        string `json:"epoch"`         // e.g. "1527077996"
+	Timestamp    string `json:"timestamp"`     // e.g. "12:19:56"
+	Cluster      string `json:"cluster"`       // cluster name, e.g. "elasticsearch"
+	Status       string `json:"status"`        // "green", "yellow", or "red"
+	NodeTotal    string `json:"node.total"`    // total number of nodes
+	NodeData     string `json:"node.data"`     // number of data nodes
+	Shards       string `json:"shards"`        // number of shards
+	Pri          string `json:"pri"`           // number of primary shards
+	Relo         string `json:"relo"`          // number of relocating shards
+	Init         string `json:"init"`          // number of initializing shards
+	Unassign     string `json:"unassign"`      // number of unassigned shards
+	PendingTasks string `json:"pending_tasks"` // number of pending tasks
+}
@@ -0,0 +1,56 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCatHealthBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *CatHealthService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewCatHealthService(nil),
+			"/_cat/health",
+			"format=json",
+		},
+		{
+			NewCatHealthService(nil).Local(true).Timestamp(false),
+			"/_cat/health",
+			"format=json&local=true&ts=false",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path = %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params = %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestCatHealthResponse(t *testing.T) {
+	body := `[{"epoch":"1475247709","timestamp":"17:01:49","cluster":"elasticsearch","status":"green","node.total":"1","node.data":"1","shards":"5","pri":"5","relo":"0","init":"0","unassign":"0","pending_tasks":"0"}]`
+	var resp CatHealthResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("expected %d row; got: %d", 1, len(resp))
+	}
+	if resp[0].Cluster != "elasticsearch" || resp[0].Status != "green" || resp[0].NodeTotal != "1" {
+		t.Errorf("unexpected row: %+v", resp[0])
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/olivere/elastic/uritemplates"
+)
+
+// CatIndicesService returns a list of indices with e.g. their health,
+// number of documents and store size. The cat API is requested in JSON
+// format, so the rows can be used without parsing a text table.
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/cat-indices.html.
+type CatIndicesService struct {
+	client        *Client
+	pretty        bool
+	index         []string
+	bytes         string
+	local         *bool
+	masterTimeout string
+	columns       []string
+	primary       *bool
+}
+
+// NewCatIndicesService creates a new CatIndicesService.
+func NewCatIndicesService(client *Client) *CatIndicesService {
+	return &CatIndicesService{
+		client: client,
+		index:  make([]string, 0),
+	}
+}
+
+// Index is a list of index names (or patterns) to limit the returned
+// information to.
+func (s *CatIndicesService) Index(index ...string) *CatIndicesService {
+	s.index = append(s.index, index...)
+	return s
+}
+
+// Bytes is the unit in which to display byte values,
+// e.g. "b", "k", "m", or "g".
+func (s *CatIndicesService) Bytes(bytes string) *CatIndicesService {
+	s.bytes = bytes
+	return s
+}
+
+// Local indicates to return local information, i.e. do not retrieve
+// the state from the master node (default: false).
+func (s *CatIndicesService) Local(local bool) *CatIndicesService {
+	s.local = &local
+	return s
+}
+
+// MasterTimeout is the explicit operation timeout for connection to master node.
+func (s *CatIndicesService) MasterTimeout(masterTimeout string) *CatIndicesService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Columns restricts the returned columns, e.g. "index" and "docs.count".
+// All other fields of the rows are empty then.
+func (s *CatIndicesService) Columns(columns ...string) *CatIndicesService {
+	s.columns = append(s.columns, columns...)
+	return s
+}
+
+// Primary indicates to return stats only for primary shards.
+func (s *CatIndicesService) Primary(primary bool) *CatIndicesService {
+	s.primary = &primary
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *CatIndicesService) Pretty(pretty bool) *CatIndicesService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *CatIndicesService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/_cat/indices/{index}", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_cat/indices"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{
+		"format": []string{"json"}, // always returns as JSON
+	}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.bytes != "" {
+		params.Set("bytes", s.bytes)
+	}
+	if s.local != nil {
+		params.Set("local", fmt.Sprintf("%v", *s.local))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if len(s.columns) > 0 {
+		params.Set("h", strings.Join(s.columns, ","))
+	}
+	if s.primary != nil {
+		params.Set("pri", fmt.Sprintf("%v", *s.primary))
+	}
+	return path, params, nil
+}
+
+// Do executes the operation.
+func (s *CatIndicesService) Do() (CatIndicesResponse, error) {
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest("GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	var ret CatIndicesResponse
+	if err := json.Unmarshal(res.Body, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CatIndicesResponse is the response of CatIndicesService.Do.
+type CatIndicesResponse []CatIndicesResponseRow
+
+// CatIndicesResponseRow is a single row of the CatIndicesResponse.
+// Elasticsearch returns all values as strings.
+type CatIndicesResponseRow struct {
+	Health       string `json:"health"`         // "green", "yellow", or "red"
+	Status       string `json:"status"`         // "open" or "close"
+	Index        string `json:"index"`          // index name
+	Pri          string `json:"pri"`            // number of primary shards
+	Rep          string `json:"rep"`            // number of replica shards
+	DocsCount    string `json:"docs.count"`     // number of available docs
+	DocsDeleted  string `json:"docs.deleted"`   // number of deleted docs
+	StoreSize    string `json:"store.size"`     // store size of primaries and replicas, e.g. "4.6kb"
+	PriStoreSize string `json:"pri.store.size"` // store size of primaries, e.g. "230b"
+}
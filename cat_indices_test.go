@@ -0,0 +1,65 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCatIndicesBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *CatIndicesService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewCatIndicesService(nil),
+			"/_cat/indices",
+			"format=json",
+		},
+		{
+			NewCatIndicesService(nil).Index("twitter", "logs-*").Bytes("b"),
+			"/_cat/indices/twitter%2Clogs-%2A",
+			"bytes=b&format=json",
+		},
+		{
+			NewCatIndicesService(nil).Columns("index", "docs.count").Primary(true),
+			"/_cat/indices",
+			"format=json&h=index%2Cdocs.count&pri=true",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path = %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params = %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestCatIndicesResponse(t *testing.T) {
+	body := `[
+		{"health":"green","status":"open","index":"twitter","pri":"5","rep":"1","docs.count":"1200","docs.deleted":"0","store.size":"88.1kb","pri.store.size":"44kb"},
+		{"health":"yellow","status":"open","index":"logs","pri":"1","rep":"1","docs.count":"3","docs.deleted":"1","store.size":"10kb","pri.store.size":"10kb"}
+	]`
+	var resp CatIndicesResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 2 {
+		t.Fatalf("expected %d rows; got: %d", 2, len(resp))
+	}
+	row := resp[0]
+	if row.Index != "twitter" || row.Health != "green" || row.DocsCount != "1200" || row.StoreSize != "88.1kb" {
+		t.Errorf("unexpected first row: %+v", row)
+	}
+}
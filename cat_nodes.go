@@ -0,0 +1,119 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// CatNodesService returns information about the nodes of the cluster,
+// e.g. their roles and memory usage. The cat API is requested in
+// JSON format.
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/cat-nodes.html.
+type CatNodesService struct {
+	client        *Client
+	pretty        bool
+	local         *bool
+	masterTimeout string
+	columns       []string
+}
+
+// NewCatNodesService creates a new CatNodesService.
+func NewCatNodesService(client *Client) *CatNodesService {
+	return &CatNodesService{
+		client: client,
+	}
+}
+
+// Local indicates to return local information, i.e. do not retrieve
+// the state from the master node (default: false).
+func (s *CatNodesService) Local(local bool) *CatNodesService {
+	s.local = &local
+	return s
+}
+
+// MasterTimeout is the explicit operation timeout for connection to master node.
+func (s *CatNodesService) MasterTimeout(masterTimeout string) *CatNodesService {
+	s.masterTimeout = masterTimeout
+	return s
+}
+
+// Columns restricts the returned columns, e.g. "name" and "heap.percent".
+// All other fields of the rows are empty then.
+func (s *CatNodesService) Columns(columns ...string) *CatNodesService {
+	s.columns = append(s.columns, columns...)
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *CatNodesService) Pretty(pretty bool) *CatNodesService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *CatNodesService) buildURL() (string, url.Values, error) {
+	path := "/_cat/nodes"
+
+	// Add query string parameters
+	params := url.Values{
+		"format": []string{"json"}, // always returns as JSON
+	}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.local != nil {
+		params.Set("local", fmt.Sprintf("%v", *s.local))
+	}
+	if s.masterTimeout != "" {
+		params.Set("master_timeout", s.masterTimeout)
+	}
+	if len(s.columns) > 0 {
+		params.Set("h", strings.Join(s.columns, ","))
+	}
+	return path, params, nil
+}
+
+// Do executes the operation.
+func (s *CatNodesService) Do() (CatNodesResponse, error) {
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest("GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	var ret CatNodesResponse
+	if err := json.Unmarshal(res.Body, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// CatNodesResponse is the response of CatNodesService.Do.
+type CatNodesResponse []CatNodesResponseRow
+
+// CatNodesResponseRow is a single row of the CatNodesResponse.
+// Elasticsearch returns all values as strings.
+type CatNodesResponseRow struct {
+	Host        string `json:"host"`         // host name
+	IP          string `json:"ip"`           // IP address
+	HeapPercent string `json:"heap.percent"` // used heap in percent
+	RAMPercent  string `json:"ram.percent"`  // used memory in percent
+	Load        string `json:"load"`         // load average
+	NodeRole    string `json:"node.role"`    // e.g. "d" for data nodes, "c" for client nodes
+	Master      string `json:"master"`       // "*" for the elected master, "m" for master-eligible nodes
+	Name        string `json:"name"`         // node name
+}
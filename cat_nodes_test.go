@@ -0,0 +1,56 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestCatNodesBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *CatNodesService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewCatNodesService(nil),
+			"/_cat/nodes",
+			"format=json",
+		},
+		{
+			NewCatNodesService(nil).Columns("name", "heap.percent").MasterTimeout("10s"),
+			"/_cat/nodes",
+			"format=json&h=name%2Cheap.percent&master_timeout=10s",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path = %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params = %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestCatNodesResponse(t *testing.T) {
+	body := `[{"host":"es1","ip":"192.168.1.2","heap.percent":"42","ram.percent":"87","load":"0.12","node.role":"d","master":"*","name":"Doctor Strange"}]`
+	var resp CatNodesResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp) != 1 {
+		t.Fatalf("expected %d row; got: %d", 1, len(resp))
+	}
+	if resp[0].Name != "Doctor Strange" || resp[0].IP != "192.168.1.2" || resp[0].Master != "*" {
+		t.Errorf("unexpected row: %+v", resp[0])
+	}
+}
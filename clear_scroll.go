@@ -24,11 +24,9 @@ var (
 
 // ClearScrollService is documented at http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/search-request-scroll.html.
 type ClearScrollService struct {
-	client     *Client
-	pretty     bool
-	scrollId   []string
-	bodyJson   interface{}
-	bodyString string
+	client   *Client
+	pretty   bool
+	scrollId []string
 }
 
 // NewClearScrollService creates a new ClearScrollService.
@@ -47,6 +45,12 @@ func (s *ClearScrollService) ScrollId(scrollId ...string) *ClearScrollService {
 	return s
 }
 
+// ClearAll clears all search contexts on the cluster.
+// It is a shortcut for ScrollId("_all").
+func (s *ClearScrollService) ClearAll() *ClearScrollService {
+	return s.ScrollId("_all")
+}
+
 // buildURL builds the URL for the operation.
 func (s *ClearScrollService) buildURL() (string, url.Values, error) {
 	path, err := uritemplates.Expand("/_search/scroll", map[string]string{})
@@ -58,9 +62,23 @@ func (s *ClearScrollService) buildURL() (string, url.Values, error) {
 
 // Validate checks if the operation is valid.
 func (s *ClearScrollService) Validate() error {
+	var invalid []string
+	if len(s.scrollId) == 0 {
+		invalid = append(invalid, "ScrollId")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
 	return nil
 }
 
+// body returns the body for the request.
+func (s *ClearScrollService) body() interface{} {
+	return map[string]interface{}{
+		"scroll_id": s.scrollId,
+	}
+}
+
 // Do executes the operation.
 func (s *ClearScrollService) Do() (*ClearScrollResponse, error) {
 	// Check pre-conditions
@@ -74,11 +92,8 @@ func (s *ClearScrollService) Do() (*ClearScrollResponse, error) {
 		return nil, err
 	}
 
-	// Setup HTTP request body
-	body := strings.Join(s.scrollId, ",")
-
 	// Get HTTP response
-	res, err := s.client.PerformRequest("DELETE", path, params, body)
+	res, err := s.client.PerformRequest("DELETE", path, params, s.body())
 	if err != nil {
 		return nil, err
 	}
@@ -93,4 +108,6 @@ func (s *ClearScrollService) Do() (*ClearScrollResponse, error) {
 
 // ClearScrollResponse is the response of ClearScrollService.Do.
 type ClearScrollResponse struct {
+	Succeeded bool `json:"succeeded"`
+	NumFreed  int  `json:"num_freed"`
 }
@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	_ "net/http"
 	"testing"
 )
@@ -61,7 +62,10 @@ func TestClearScroll(t *testing.T) {
 		t.Fatal(err)
 	}
 	if clearScrollRes == nil {
-		t.Error("expected results != nil; got nil")
+		t.Fatal("expected results != nil; got nil")
+	}
+	if !clearScrollRes.Succeeded {
+		t.Errorf("expected Succeeded = %v; got %v", true, clearScrollRes.Succeeded)
 	}
 
 	// Search result should fail
@@ -70,3 +74,40 @@ func TestClearScroll(t *testing.T) {
 		t.Fatalf("expected scroll to fail")
 	}
 }
+
+func TestClearScrollBody(t *testing.T) {
+	tests := []struct {
+		Service  *ClearScrollService
+		Expected string
+	}{
+		{
+			NewClearScrollService(nil).ScrollId("abc"),
+			`{"scroll_id":["abc"]}`,
+		},
+		{
+			NewClearScrollService(nil).ScrollId("abc", "def"),
+			`{"scroll_id":["abc","def"]}`,
+		},
+		{
+			NewClearScrollService(nil).ClearAll(),
+			`{"scroll_id":["_all"]}`,
+		},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.Service.body())
+		if err != nil {
+			t.Fatalf("marshaling to JSON failed: %v", err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("expected\n%s\n,got:\n%s", test.Expected, got)
+		}
+	}
+}
+
+func TestClearScrollValidate(t *testing.T) {
+	if err := NewClearScrollService(nil).Validate(); err == nil {
+		t.Fatal("expected error when no scroll id is given")
+	}
+}
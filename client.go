@@ -6,11 +6,10 @@ package elastic
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"log"
-	"math/rand"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -50,6 +49,15 @@ const (
 	// two health checks of the nodes in the cluster.
 	DefaultHealthcheckInterval = 60 * time.Second
 
+	// DefaultDeadConnDelay is the time a client waits before it tries a
+	// dead connection again if all connections are dead. The time doubles
+	// with every failure of the connection, up to DefaultDeadConnMaxDelay.
+	DefaultDeadConnDelay = 1 * time.Second
+
+	// DefaultDeadConnMaxDelay is the maximum time a client waits before
+	// it tries a dead connection again if all connections are dead.
+	DefaultDeadConnMaxDelay = 60 * time.Second
+
 	// DefaultSnifferEnabled specifies if the sniffer is enabled by default.
 	DefaultSnifferEnabled = true
 
@@ -72,6 +80,14 @@ const (
 	// Elastic will give up and return an error. It is zero by default, so
 	// retry is disabled by default.
 	DefaultMaxRetries = 0
+
+	// DefaultRetryInitialWait is the time the default Retrier waits before
+	// the first retry. It doubles with every retry.
+	DefaultRetryInitialWait = 100 * time.Millisecond
+
+	// DefaultRetryMaxWait is the maximum time the default Retrier waits
+	// between two retries.
+	DefaultRetryMaxWait = 10 * time.Second
 )
 
 var (
@@ -102,16 +118,24 @@ type Client struct {
 	mu                        sync.RWMutex  // guards the next block
 	urls                      []string      // set of URLs passed initially to the client
 	running                   bool          // true if the client's background processes are running
-	errorlog                  *log.Logger   // error log for critical messages
-	infolog                   *log.Logger   // information log for e.g. response times
-	tracelog                  *log.Logger   // trace log for debugging
+	errorlog                  Logger        // error log for critical messages
+	infolog                   Logger        // information log for e.g. response times
+	tracelog                  Logger        // trace log for debugging
 	maxRetries                int           // max. number of retries
+	retrier                   Retrier       // decides whether to retry failed requests (uses maxRetries if nil)
+	gzipEnabled               bool          // gzip compression enabled or disabled
+	basicAuth                 bool          // true if basic authentication is used
+	basicAuthUsername         string        // username for basic authentication
+	basicAuthPassword         string        // password for basic authentication
+	headers                   http.Header   // custom headers sent with every request
 	scheme                    string        // http or https
 	healthcheckEnabled        bool          // healthchecks enabled or disabled
 	healthcheckTimeoutStartup time.Duration // time the healthcheck waits for a response from Elasticsearch on startup
 	healthcheckTimeout        time.Duration // time the healthcheck waits for a response from Elasticsearch
 	healthcheckInterval       time.Duration // interval between healthchecks
 	healthcheckStop           chan bool     // notify healthchecker to stop, and notify back
+	deadConnDelay             time.Duration // time to wait before a dead connection is tried again
+	deadConnMaxDelay          time.Duration // maximum time to wait before a dead connection is tried again
 	snifferEnabled            bool          // sniffer enabled or disabled
 	snifferTimeoutStartup     time.Duration // time the sniffer waits for a response from nodes info API on startup
 	snifferTimeout            time.Duration // time the sniffer waits for a response from nodes info API
@@ -152,10 +176,13 @@ type Client struct {
 // Disabling health checks is not recommended, but can be done by
 // SetHealthcheck(false).
 //
-// Connections are automatically marked as dead or healthy while
-// making requests to Elasticsearch. When a request fails, Elastic will
-// retry up to a maximum number of retries configured with SetMaxRetries.
-// Retries are disabled by default.
+// Requests are distributed round-robin over all connections that are
+// not marked as dead. Connections are automatically marked as dead or
+// healthy while making requests to Elasticsearch. When a request fails,
+// Elastic will retry up to a maximum number of retries configured with
+// SetMaxRetries, using the next available connection. Retries are
+// disabled by default. If all connections are dead, Elastic tries them
+// again with an exponential backoff configured with SetDeadConnBackoff.
 //
 // If no HttpClient is configured, then http.DefaultClient is used.
 // You can use your own http.Client with some http.Transport for
@@ -177,6 +204,8 @@ func NewClient(options ...ClientOptionFunc) (*Client, error) {
 		healthcheckTimeout:        DefaultHealthcheckTimeout,
 		healthcheckInterval:       DefaultHealthcheckInterval,
 		healthcheckStop:           make(chan bool),
+		deadConnDelay:             DefaultDeadConnDelay,
+		deadConnMaxDelay:          DefaultDeadConnMaxDelay,
 		snifferEnabled:            DefaultSnifferEnabled,
 		snifferTimeoutStartup:     DefaultSnifferTimeoutStartup,
 		snifferTimeout:            DefaultSnifferTimeout,
@@ -341,8 +370,24 @@ func SetHealthcheckInterval(interval time.Duration) ClientOptionFunc {
 	}
 }
 
+// SetDeadConnBackoff sets the time to wait before a dead connection is
+// tried again if all connections are dead. The time doubles with every
+// failure of the connection, but never exceeds maxDelay. The defaults are
+// DefaultDeadConnDelay and DefaultDeadConnMaxDelay.
+//
+// Notice that dead connections are also resurrected by the periodic
+// health checks (see SetHealthcheckInterval).
+func SetDeadConnBackoff(delay, maxDelay time.Duration) ClientOptionFunc {
+	return func(c *Client) error {
+		c.deadConnDelay = delay
+		c.deadConnMaxDelay = maxDelay
+		return nil
+	}
+}
+
 // SetMaxRetries sets the maximum number of retries before giving up when
-// performing a HTTP request to Elasticsearch.
+// performing a HTTP request to Elasticsearch. It configures the default
+// Retrier (see BackoffRetrier) and is ignored if SetRetrier is used.
 func SetMaxRetries(maxRetries int) func(*Client) error {
 	return func(c *Client) error {
 		if maxRetries < 0 {
@@ -353,6 +398,53 @@ func SetMaxRetries(maxRetries int) func(*Client) error {
 	}
 }
 
+// SetRetrier sets the Retrier that decides whether and when to retry
+// a failed request to Elasticsearch. By default, a BackoffRetrier with
+// the number of retries set by SetMaxRetries is used.
+func SetRetrier(retrier Retrier) ClientOptionFunc {
+	return func(c *Client) error {
+		c.retrier = retrier
+		return nil
+	}
+}
+
+// SetBasicAuth sets the username and password for HTTP basic
+// authentication, e.g. if Elasticsearch is behind an authenticating proxy.
+// The credentials are sent with every request, including sniffing and
+// health checks. They are redacted in the trace log.
+func SetBasicAuth(username, password string) ClientOptionFunc {
+	return func(c *Client) error {
+		c.basicAuth = true
+		c.basicAuthUsername = username
+		c.basicAuthPassword = password
+		return nil
+	}
+}
+
+// SetHeader sets a custom HTTP header that is sent with every request,
+// including sniffing and health checks. It replaces the value of a header
+// set by Elastic itself, e.g. User-Agent.
+func SetHeader(key, value string) ClientOptionFunc {
+	return func(c *Client) error {
+		if c.headers == nil {
+			c.headers = make(http.Header)
+		}
+		c.headers.Set(key, value)
+		return nil
+	}
+}
+
+// SetGzip enables or disables gzip compression (disabled by default).
+// If enabled, request bodies are gzip-compressed and Elasticsearch is
+// asked to compress its responses. Notice that Elasticsearch only
+// compresses responses if http.compression is enabled on the server.
+func SetGzip(enabled bool) ClientOptionFunc {
+	return func(c *Client) error {
+		c.gzipEnabled = enabled
+		return nil
+	}
+}
+
 // SetDecoder sets the Decoder to use when decoding data from Elasticsearch.
 // DefaultDecoder is used by default.
 func SetDecoder(decoder Decoder) func(*Client) error {
@@ -368,27 +460,27 @@ func SetDecoder(decoder Decoder) func(*Client) error {
 
 // SetErrorLog sets the logger for critical messages like nodes joining
 // or leaving the cluster or failing requests. It is nil by default.
-func SetErrorLog(logger *log.Logger) func(*Client) error {
+func SetErrorLog(logger Logger) func(*Client) error {
 	return func(c *Client) error {
-		c.errorlog = logger
+		c.errorlog = nilIfNoLogger(logger)
 		return nil
 	}
 }
 
 // SetInfoLog sets the logger for informational messages, e.g. requests
 // and their response times. It is nil by default.
-func SetInfoLog(logger *log.Logger) func(*Client) error {
+func SetInfoLog(logger Logger) func(*Client) error {
 	return func(c *Client) error {
-		c.infolog = logger
+		c.infolog = nilIfNoLogger(logger)
 		return nil
 	}
 }
 
-// SetTraceLog specifies the log.Logger to use for output of HTTP requests
+// SetTraceLog specifies the Logger to use for output of HTTP requests
 // and responses which is helpful during debugging. It is nil by default.
-func SetTraceLog(logger *log.Logger) func(*Client) error {
+func SetTraceLog(logger Logger) func(*Client) error {
 	return func(c *Client) error {
-		c.tracelog = logger
+		c.tracelog = nilIfNoLogger(logger)
 		return nil
 	}
 }
@@ -490,6 +582,11 @@ func (c *Client) tracef(format string, args ...interface{}) {
 // dumpRequest dumps the given HTTP request to the trace log.
 func (c *Client) dumpRequest(r *http.Request) {
 	if c.tracelog != nil {
+		// Do not reveal credentials in the trace log
+		if auth := r.Header.Get("Authorization"); auth != "" {
+			r.Header.Set("Authorization", "[redacted]")
+			defer r.Header.Set("Authorization", auth)
+		}
 		out, err := httputil.DumpRequestOut(r, true)
 		if err == nil {
 			c.tracef("%s\n", string(out))
@@ -572,24 +669,46 @@ func (c *Client) sniff(timeout time.Duration) error {
 	}
 
 	// Wait for the results to come back, or the process times out.
-	for {
+	// If no node returns any results, we keep the connections of the
+	// preceding sniffing process.
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for pending := len(urls); pending > 0; pending-- {
 		select {
 		case conns := <-ch:
 			if len(conns) > 0 {
 				c.updateConns(conns)
 				return nil
 			}
-		case <-time.After(timeout):
+		case <-timer.C:
 			// We get here if no cluster responds in time
 			return ErrNoClient
 		}
 	}
+	return ErrNoClient
 }
 
 // reSniffHostAndPort is used to extract hostname and port from a result
 // from a Nodes Info API (example: "inet[/127.0.0.1:9200]").
 var reSniffHostAndPort = regexp.MustCompile(`\/([^:]*):([0-9]+)\]`)
 
+// reSniffPublishAddress is used to extract hostname and port from a
+// publish address of the Nodes Info API in Elasticsearch 2.x and later
+// (example: "127.0.0.1:9200" or "localhost/127.0.0.1:9200").
+var reSniffPublishAddress = regexp.MustCompile(`^(?:[^/]*/)?([^/:\[\]]+):([0-9]+)$`)
+
+// extractHostAndPort returns the hostname and port of an address as
+// returned by the Nodes Info API, or false if it cannot be parsed.
+func extractHostAndPort(address string) (string, string, bool) {
+	if m := reSniffHostAndPort.FindStringSubmatch(address); len(m) == 3 {
+		return m[1], m[2], true
+	}
+	if m := reSniffPublishAddress.FindStringSubmatch(address); len(m) == 3 {
+		return m[1], m[2], true
+	}
+	return "", "", false
+}
+
 // sniffNode sniffs a single node. This method is run as a goroutine
 // in sniff. If successful, it returns the list of node URLs extracted
 // from the result of calling Nodes Info API. Otherwise, an empty array
@@ -602,6 +721,7 @@ func (c *Client) sniffNode(url string) []*conn {
 	if err != nil {
 		return nodes
 	}
+	c.setRequestHeaders(req)
 
 	res, err := c.c.Do((*http.Request)(req))
 	if err != nil {
@@ -614,26 +734,26 @@ func (c *Client) sniffNode(url string) []*conn {
 	if res.Body != nil {
 		defer res.Body.Close()
 	}
+	gunzipResponse(res)
 
 	var info NodesInfoResponse
 	if err := json.NewDecoder(res.Body).Decode(&info); err == nil {
 		if len(info.Nodes) > 0 {
-			switch c.scheme {
-			case "https":
-				for nodeID, node := range info.Nodes {
-					m := reSniffHostAndPort.FindStringSubmatch(node.HTTPSAddress)
-					if len(m) == 3 {
-						url := fmt.Sprintf("https://%s:%s", m[1], m[2])
-						nodes = append(nodes, newConn(nodeID, url))
-					}
+			for nodeID, node := range info.Nodes {
+				var scheme, address string
+				switch c.scheme {
+				case "https":
+					scheme, address = "https", node.HTTPSAddress
+				default:
+					scheme, address = "http", node.HTTPAddress
 				}
-			default:
-				for nodeID, node := range info.Nodes {
-					m := reSniffHostAndPort.FindStringSubmatch(node.HTTPAddress)
-					if len(m) == 3 {
-						url := fmt.Sprintf("http://%s:%s", m[1], m[2])
-						nodes = append(nodes, newConn(nodeID, url))
-					}
+				if address == "" && node.HTTP != nil {
+					// Elasticsearch 2.x and later only return the publish address
+					address = node.HTTP.PublishAddress
+				}
+				if host, port, ok := extractHostAndPort(address); ok {
+					url := fmt.Sprintf("%s://%s:%s", scheme, host, port)
+					nodes = append(nodes, newConn(nodeID, url))
 				}
 			}
 		}
@@ -715,6 +835,7 @@ func (c *Client) healthcheck(timeout time.Duration, force bool) {
 		params.Set("timeout", fmt.Sprintf("%dms", timeoutInMillis))
 		req, err := NewRequest("HEAD", conn.URL()+"/?"+params.Encode())
 		if err == nil {
+			c.setRequestHeaders(req)
 			res, err := c.c.Do((*http.Request)(req))
 			if err == nil {
 				if res.Body != nil {
@@ -739,6 +860,11 @@ func (c *Client) healthcheck(timeout time.Duration, force bool) {
 
 // next returns the next available connection, or ErrNoClient.
 func (c *Client) next() (*conn, error) {
+	c.mu.RLock()
+	deadConnDelay := c.deadConnDelay
+	deadConnMaxDelay := c.deadConnMaxDelay
+	c.mu.RUnlock()
+
 	// We do round-robin here.
 	// TODO(oe) This should be a pluggable strategy, like the Selector in the official clients.
 	c.connsMu.Lock()
@@ -761,7 +887,18 @@ func (c *Client) next() (*conn, error) {
 		}
 	}
 
-	// TODO(oe) As a last resort, we could try to awake a dead connection here.
+	// As a last resort, we try a dead connection that has been dead for
+	// long enough, so we don't have to wait for the next health check.
+	for i = 0; i < numConns; i++ {
+		c.cindex += 1
+		if c.cindex >= numConns {
+			c.cindex = 0
+		}
+		conn := c.conns[c.cindex]
+		if conn.IsRetryable(deadConnDelay, deadConnMaxDelay) {
+			return conn, nil
+		}
+	}
 
 	// We tried hard, but there is no node available
 	return nil, ErrNoClient
@@ -781,14 +918,46 @@ func (c *Client) mustActiveConn() error {
 	return ErrNoClient
 }
 
+// setRequestHeaders adds the basic authentication credentials and the
+// custom headers of the client to the request.
+func (c *Client) setRequestHeaders(req *Request) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for key, values := range c.headers {
+		req.Header.Del(key)
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	if c.basicAuth {
+		(*http.Request)(req).SetBasicAuth(c.basicAuthUsername, c.basicAuthPassword)
+	}
+}
+
 // PerformRequest does a HTTP request to Elasticsearch.
 // It returns a response and an error on failure.
 func (c *Client) PerformRequest(method, path string, params url.Values, body interface{}) (*Response, error) {
+	return c.PerformRequestC(context.Background(), method, path, params, body)
+}
+
+// PerformRequestC does a HTTP request to Elasticsearch, just like
+// PerformRequest. The request is bound to the given context: If the context
+// is cancelled or its deadline expires, the request is aborted and the
+// error of the context is returned.
+func (c *Client) PerformRequestC(ctx context.Context, method, path string, params url.Values, body interface{}) (*Response, error) {
 	start := time.Now().UTC()
 
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
 	c.mu.RLock()
 	timeout := c.healthcheckTimeout
-	retries := c.maxRetries
+	retrier := c.retrier
+	if retrier == nil {
+		retrier = NewBackoffRetrier(c.maxRetries, DefaultRetryInitialWait, DefaultRetryMaxWait)
+	}
+	gzipEnabled := c.gzipEnabled
 	c.mu.RUnlock()
 
 	var err error
@@ -796,10 +965,7 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 	var req *Request
 	var resp *Response
 	var retried bool
-
-	// We wait between retries, using simple exponential back-off.
-	// TODO: Make this configurable, including the jitter.
-	retryWaitMsec := int64(100 + (rand.Intn(20) - 10))
+	var n int // number of failed attempts
 
 	for {
 		pathWithParams := path
@@ -814,13 +980,15 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 				// Force a healtcheck as all connections seem to be dead.
 				c.healthcheck(timeout, false)
 			}
-			retries -= 1
-			if retries <= 0 {
+			n++
+			wait, ok := retrier.Retry(n, nil, nil, err)
+			if !ok {
 				return nil, err
 			}
 			retried = true
-			time.Sleep(time.Duration(retryWaitMsec) * time.Millisecond)
-			retryWaitMsec += retryWaitMsec
+			if err := sleepC(ctx, wait); err != nil {
+				return nil, err
+			}
 			continue // try again
 		}
 		if err != nil {
@@ -833,9 +1001,17 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 			c.errorf("elastic: cannot create request for %s %s: %v", strings.ToUpper(method), conn.URL()+pathWithParams, err)
 			return nil, err
 		}
+		c.setRequestHeaders(req)
 
 		// Set body
-		if body != nil {
+		if gzipEnabled {
+			req.Header.Set("Accept-Encoding", "gzip")
+			if body != nil {
+				if err := req.SetBodyGzip(body); err != nil {
+					return nil, err
+				}
+			}
+		} else if body != nil {
 			switch b := body.(type) {
 			case string:
 				req.SetBodyString(b)
@@ -850,32 +1026,40 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 		c.dumpRequest((*http.Request)(req))
 
 		// Get response
-		res, err := c.c.Do((*http.Request)(req))
+		res, err := c.c.Do((*http.Request)(req).WithContext(ctx))
 		if err != nil {
-			retries -= 1
-			if retries <= 0 {
-				c.errorf("elastic: %s is dead", conn.URL())
-				conn.MarkAsDead()
+			if ctx.Err() != nil {
+				// The caller gave up, so don't blame the connection
+				return nil, ctx.Err()
+			}
+			// Mark the connection as dead, so a retry uses another node
+			c.errorf("elastic: %s is dead", conn.URL())
+			conn.MarkAsDead()
+			n++
+			wait, ok := retrier.Retry(n, (*http.Request)(req), nil, err)
+			if !ok {
 				return nil, err
 			}
 			retried = true
-			time.Sleep(time.Duration(retryWaitMsec) * time.Millisecond)
-			retryWaitMsec += retryWaitMsec
+			if err := sleepC(ctx, wait); err != nil {
+				return nil, err
+			}
 			continue // try again
 		}
-		if res.Body != nil {
-			defer res.Body.Close()
-		}
+		gunzipResponse(res)
 
 		// Check for errors
 		if err := checkResponse(res); err != nil {
-			retries -= 1
-			if retries <= 0 {
+			closeBody(res)
+			n++
+			wait, ok := retrier.Retry(n, (*http.Request)(req), res, err)
+			if !ok {
 				return nil, err
 			}
 			retried = true
-			time.Sleep(time.Duration(retryWaitMsec) * time.Millisecond)
-			retryWaitMsec += retryWaitMsec
+			if err := sleepC(ctx, wait); err != nil {
+				return nil, err
+			}
 			continue // try again
 		}
 
@@ -886,6 +1070,7 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 		conn.MarkAsHealthy()
 
 		resp, err = c.newResponse(res)
+		closeBody(res)
 		if err != nil {
 			return nil, err
 		}
@@ -903,6 +1088,25 @@ func (c *Client) PerformRequest(method, path string, params url.Values, body int
 	return resp, nil
 }
 
+// closeBody closes the body of the given response, if any.
+func closeBody(res *http.Response) {
+	if res.Body != nil {
+		res.Body.Close()
+	}
+}
+
+// sleepC waits for the given duration or until the context is done,
+// whichever comes first. It returns the error of the context if the
+// context is done before the duration passed.
+func sleepC(ctx context.Context, d time.Duration) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(d):
+		return nil
+	}
+}
+
 // ElasticsearchVersion returns the version number of Elasticsearch
 // running on the given URL.
 func (c *Client) ElasticsearchVersion(url string) (string, error) {
@@ -995,8 +1199,31 @@ func (c *Client) IndexGet() *IndicesGetService {
 }
 
 // IndexGetSettings retrieves settings about one or more indices.
-func (c *Client) IndexGetSettings() *IndicesGetSettingsService {
+func (c *Client) IndexGetSettings(indices ...string) *IndicesGetSettingsService {
 	builder := NewIndicesGetSettingsService(c)
+	builder.Index(indices...)
+	return builder
+}
+
+// Validate checks whether a query is valid without executing it.
+func (c *Client) Validate(indices ...string) *ValidateService {
+	builder := NewValidateService(c)
+	builder.Index(indices...)
+	return builder
+}
+
+// Analyze explains how a text is broken into tokens. Pass an empty
+// index name to use the analyzers available on the cluster.
+func (c *Client) Analyze(index string) *IndicesAnalyzeService {
+	builder := NewIndicesAnalyzeService(c)
+	builder.Index(index)
+	return builder
+}
+
+// IndexPutSettings sets settings for one or more indices.
+func (c *Client) IndexPutSettings(indices ...string) *IndicesPutSettingsService {
+	builder := NewIndicesPutSettingsService(c)
+	builder.Index(indices...)
 	return builder
 }
 
@@ -1013,8 +1240,9 @@ func (c *Client) Delete() *DeleteService {
 }
 
 // DeleteByQuery deletes documents as found by a query.
-func (c *Client) DeleteByQuery() *DeleteByQueryService {
+func (c *Client) DeleteByQuery(indices ...string) *DeleteByQueryService {
 	builder := NewDeleteByQueryService(c)
+	builder.Indices(indices...)
 	return builder
 }
 
@@ -1108,8 +1336,9 @@ func (c *Client) Refresh(indices ...string) *RefreshService {
 
 // Flush asks Elasticsearch to free memory from the index and
 // flush data to disk.
-func (c *Client) Flush() *FlushService {
+func (c *Client) Flush(indices ...string) *FlushService {
 	builder := NewFlushService(c)
+	builder.Indices(indices...)
 	return builder
 }
 
@@ -1126,6 +1355,12 @@ func (c *Client) Bulk() *BulkService {
 	return builder
 }
 
+// BulkProcessor sets up a BulkProcessor that commits bulk requests
+// to Elasticsearch in the background.
+func (c *Client) BulkProcessor() *BulkProcessorService {
+	return NewBulkProcessorService(c)
+}
+
 // Alias enables the caller to add and/or remove aliases.
 func (c *Client) Alias() *AliasService {
 	builder := NewAliasService(c)
@@ -1223,6 +1458,27 @@ func (c *Client) NodesInfo() *NodesInfoService {
 	return NewNodesInfoService(c)
 }
 
+// NodesStats retrieves one or more or all of the cluster nodes statistics.
+func (c *Client) NodesStats() *NodesStatsService {
+	return NewNodesStatsService(c)
+}
+
+// CatIndices returns a list of indices with e.g. their health,
+// number of documents and store size.
+func (c *Client) CatIndices() *CatIndicesService {
+	return NewCatIndicesService(c)
+}
+
+// CatHealth returns a terse representation of the cluster health.
+func (c *Client) CatHealth() *CatHealthService {
+	return NewCatHealthService(c)
+}
+
+// CatNodes returns information about the nodes of the cluster.
+func (c *Client) CatNodes() *CatNodesService {
+	return NewCatNodesService(c)
+}
+
 // Reindex returns a service that will reindex documents from a source
 // index into a target index. See
 // http://www.elastic.co/guide/en/elasticsearch/guide/current/reindex.html
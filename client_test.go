@@ -6,9 +6,13 @@ package elastic
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
+	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"regexp"
 	"strings"
 	"testing"
@@ -266,6 +270,127 @@ func TestClientSniffOnDefaultURL(t *testing.T) {
 	}
 }
 
+func TestClientSniffWithPublishAddress(t *testing.T) {
+	var failing bool
+	var ts *httptest.Server
+	ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/_nodes/http" {
+			// Health checks
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if failing {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		// Elasticsearch 2.x only returns the publish address of the http module
+		address := strings.TrimPrefix(ts.URL, "http://")
+		fmt.Fprintf(w, `{"cluster_name":"elasticsearch","nodes":{"node1":{"name":"Mister Fear","http":{"bound_address":[%q],"publish_address":%q}}}}`, address, address)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSnifferTimeout(500*time.Millisecond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+	if len(client.conns) != 1 {
+		t.Fatalf("expected %d nodes; got: %d (%v)", 1, len(client.conns), client.conns)
+	}
+	if got := client.conns[0].URL(); got != ts.URL {
+		t.Fatalf("expected node URL %q; got: %q", ts.URL, got)
+	}
+	if got := client.conns[0].NodeID(); got != "node1" {
+		t.Fatalf("expected node ID %q; got: %q", "node1", got)
+	}
+
+	// A failing sniff process must keep the connections found before
+	failing = true
+	if err := client.sniff(client.snifferTimeout); err != ErrNoClient {
+		t.Fatalf("expected %v; got: %v", ErrNoClient, err)
+	}
+	if len(client.conns) != 1 {
+		t.Fatalf("expected %d nodes; got: %d (%v)", 1, len(client.conns), client.conns)
+	}
+	if got := client.conns[0].URL(); got != ts.URL {
+		t.Fatalf("expected node URL %q; got: %q", ts.URL, got)
+	}
+}
+
+func TestClientExtractHostAndPort(t *testing.T) {
+	tests := []struct {
+		Address string
+		Host    string
+		Port    string
+		OK      bool
+	}{
+		{"inet[/127.0.0.1:9200]", "127.0.0.1", "9200", true},
+		{"inet[myhost/127.0.0.1:9200]", "127.0.0.1", "9200", true},
+		{"127.0.0.1:9200", "127.0.0.1", "9200", true},
+		{"myhost/127.0.0.1:9200", "127.0.0.1", "9200", true},
+		{"myhost:9201", "myhost", "9201", true},
+		{"", "", "", false},
+		{"127.0.0.1", "", "", false},
+	}
+
+	for _, test := range tests {
+		host, port, ok := extractHostAndPort(test.Address)
+		if ok != test.OK {
+			t.Errorf("%q: expected ok = %v; got: %v", test.Address, test.OK, ok)
+		}
+		if host != test.Host {
+			t.Errorf("%q: expected host %q; got: %q", test.Address, test.Host, host)
+		}
+		if port != test.Port {
+			t.Errorf("%q: expected port %q; got: %q", test.Address, test.Port, port)
+		}
+	}
+}
+
+func TestClientSelectConnAllDeadRetriesAfterBackoff(t *testing.T) {
+	client := &Client{
+		conns: []*conn{
+			newConn("node1", "http://127.0.0.1:9200"),
+			newConn("node2", "http://127.0.0.1:9201"),
+		},
+		cindex:           -1,
+		deadConnDelay:    50 * time.Millisecond,
+		deadConnMaxDelay: time.Second,
+	}
+
+	// Both are dead
+	client.conns[0].MarkAsDead()
+	client.conns[1].MarkAsDead()
+
+	// #1: Return ErrNoClient as no connection has been dead for long enough
+	c, err := client.next()
+	if err != ErrNoClient {
+		t.Fatalf("expected %v; got: %v", ErrNoClient, err)
+	}
+	if c != nil {
+		t.Fatalf("expected no connection; got: %v", c)
+	}
+
+	// #2: Return a dead connection after the backoff elapsed
+	time.Sleep(60 * time.Millisecond)
+	c, err = client.next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c == nil {
+		t.Fatal("expected a connection; got: nil")
+	}
+
+	// #3: Return the other dead connection next (round-robin)
+	c2, err := client.next()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c2 == nil || c2.URL() == c.URL() {
+		t.Fatalf("expected the other connection; got: %v", c2)
+	}
+}
+
 // -- Selector --
 
 func TestClientSelectConnHealthy(t *testing.T) {
@@ -609,3 +734,144 @@ func TestPerformRequestWithMaxRetries(t *testing.T) {
 		t.Errorf("expected %d failed requests; got: %d", 5, numFailedReqs)
 	}
 }
+
+func TestPerformRequestWithGzip(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/twitter/_search" {
+			// Health checks
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		if got := r.Header.Get("Content-Encoding"); got != "gzip" {
+			t.Errorf("expected Content-Encoding %q; got: %q", "gzip", got)
+		}
+		if got := r.Header.Get("Accept-Encoding"); got != "gzip" {
+			t.Errorf("expected Accept-Encoding %q; got: %q", "gzip", got)
+		}
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		body, err := ioutil.ReadAll(zr)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		if got, want := string(body), `{"query":{"match_all":{}}}`; got != want {
+			t.Errorf("expected request body %s; got: %s", want, got)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("Content-Encoding", "gzip")
+		zw := gzip.NewWriter(w)
+		zw.Write([]byte(`{"took":1,"hits":{"total":0,"hits":[]}}`))
+		zw.Close()
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetGzip(true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+
+	body := map[string]interface{}{"query": map[string]interface{}{"match_all": map[string]interface{}{}}}
+	res, err := client.PerformRequest("POST", "/twitter/_search", nil, body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(res.Body), `{"took":1,"hits":{"total":0,"hits":[]}}`; got != want {
+		t.Errorf("expected response body %s; got: %s", want, got)
+	}
+}
+
+func TestPerformRequestWithBasicAuthAndHeaders(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// Health checks must be authenticated as well
+		username, password, ok := r.BasicAuth()
+		if !ok || username != "alice" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if got := r.Header.Get("X-Tenant"); got != "acme" {
+			t.Errorf("expected X-Tenant header %q on %s %s; got: %q", "acme", r.Method, r.URL.Path, got)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	var trace bytes.Buffer
+	client, err := NewClient(
+		SetURL(ts.URL),
+		SetSniff(false),
+		SetBasicAuth("alice", "secret"),
+		SetHeader("X-Tenant", "acme"),
+		SetTraceLog(log.New(&trace, "", 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+
+	res, err := client.PerformRequest("GET", "/_cluster/health", nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, want := string(res.Body), `{"acknowledged":true}`; got != want {
+		t.Errorf("expected response body %s; got: %s", want, got)
+	}
+
+	// Credentials are redacted in the trace log
+	if !strings.Contains(trace.String(), "Authorization: [redacted]") {
+		t.Errorf("expected redacted Authorization header in trace log; got:\n%s", trace.String())
+	}
+	if strings.Contains(trace.String(), "YWxpY2U6c2VjcmV0") {
+		t.Errorf("expected no credentials in trace log; got:\n%s", trace.String())
+	}
+}
+
+// testLogger is a Logger that records all messages.
+type testLogger struct {
+	messages []string
+}
+
+func (l *testLogger) Printf(format string, v ...interface{}) {
+	l.messages = append(l.messages, fmt.Sprintf(format, v...))
+}
+
+func TestClientWithCustomLogger(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.Write([]byte(`{"acknowledged":true}`))
+	}))
+	defer ts.Close()
+
+	var nilLogger *log.Logger
+	infolog := new(testLogger)
+	tracelog := new(testLogger)
+	client, err := NewClient(
+		SetURL(ts.URL),
+		SetSniff(false),
+		SetErrorLog(nilLogger),
+		SetInfoLog(infolog),
+		SetTraceLog(tracelog))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+
+	if client.errorlog != nil {
+		t.Errorf("expected a nil *log.Logger to disable the error log; got: %v", client.errorlog)
+	}
+
+	if _, err := client.PerformRequest("GET", "/_cluster/health", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(infolog.messages) != 1 || !strings.HasPrefix(infolog.messages[0], "GET ") {
+		t.Errorf("expected a single info message about the request; got: %v", infolog.messages)
+	}
+	if len(tracelog.messages) != 2 {
+		t.Errorf("expected the request and response in the trace log; got: %v", tracelog.messages)
+	}
+}
@@ -85,7 +85,9 @@ func (s *ClusterHealthService) WaitForStatus(waitForStatus string) *ClusterHealt
 	return s
 }
 
-// Level specifies the level of detail for returned information.
+// Level specifies the level of detail for returned information:
+// "cluster" (default), "indices", or "shards". See
+// ClusterHealthResponse.Indices for the details returned.
 func (s *ClusterHealthService) Level(level string) *ClusterHealthService {
 	s.level = level
 	return s
@@ -183,4 +185,34 @@ type ClusterHealthResponse struct {
 	InitializingShards   int    `json:"initializing_shards"`
 	UnassignedShards     int    `json:"unassigned_shards"`
 	NumberOfPendingTasks int    `json:"number_of_pending_tasks"`
+
+	// Indices is only returned with Level "indices" or "shards".
+	Indices map[string]*ClusterIndexHealth `json:"indices"`
+}
+
+// ClusterIndexHealth is the health of a single index, as returned
+// by ClusterHealthService with Level "indices" or "shards".
+type ClusterIndexHealth struct {
+	Status              string `json:"status"`
+	NumberOfShards      int    `json:"number_of_shards"`
+	NumberOfReplicas    int    `json:"number_of_replicas"`
+	ActivePrimaryShards int    `json:"active_primary_shards"`
+	ActiveShards        int    `json:"active_shards"`
+	RelocatingShards    int    `json:"relocating_shards"`
+	InitializingShards  int    `json:"initializing_shards"`
+	UnassignedShards    int    `json:"unassigned_shards"`
+
+	// Shards is only returned with Level "shards".
+	Shards map[string]*ClusterShardHealth `json:"shards"`
+}
+
+// ClusterShardHealth is the health of a single shard, as returned
+// by ClusterHealthService with Level "shards".
+type ClusterShardHealth struct {
+	Status             string `json:"status"`
+	PrimaryActive      bool   `json:"primary_active"`
+	ActiveShards       int    `json:"active_shards"`
+	RelocatingShards   int    `json:"relocating_shards"`
+	InitializingShards int    `json:"initializing_shards"`
+	UnassignedShards   int    `json:"unassigned_shards"`
 }
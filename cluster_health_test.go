@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"net/url"
 	"testing"
 )
@@ -57,6 +58,15 @@ func TestClusterHealthURLs(t *testing.T) {
 			ExpectedPath:   "/_cluster/health/twitter",
 			ExpectedParams: url.Values{"wait_for_status": []string{"yellow"}},
 		},
+		{
+			Service: &ClusterHealthService{
+				indices: []string{"twitter"},
+				level:   "shards",
+				timeout: "5s",
+			},
+			ExpectedPath:   "/_cluster/health/twitter",
+			ExpectedParams: url.Values{"level": []string{"shards"}, "timeout": []string{"5s"}},
+		},
 	}
 
 	for _, test := range tests {
@@ -73,6 +83,71 @@ func TestClusterHealthURLs(t *testing.T) {
 	}
 }
 
+func TestClusterHealthResponseWithIndicesAndShards(t *testing.T) {
+	body := `{
+		"cluster_name":"elasticsearch",
+		"status":"yellow",
+		"timed_out":false,
+		"number_of_nodes":1,
+		"number_of_data_nodes":1,
+		"active_primary_shards":1,
+		"active_shards":1,
+		"relocating_shards":0,
+		"initializing_shards":0,
+		"unassigned_shards":1,
+		"indices":{
+			"twitter":{
+				"status":"yellow",
+				"number_of_shards":1,
+				"number_of_replicas":1,
+				"active_primary_shards":1,
+				"active_shards":1,
+				"relocating_shards":0,
+				"initializing_shards":0,
+				"unassigned_shards":1,
+				"shards":{
+					"0":{
+						"status":"yellow",
+						"primary_active":true,
+						"active_shards":1,
+						"relocating_shards":0,
+						"initializing_shards":0,
+						"unassigned_shards":1
+					}
+				}
+			}
+		}
+	}`
+
+	var res ClusterHealthResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Status != "yellow" {
+		t.Errorf("expected Status = %q; got: %q", "yellow", res.Status)
+	}
+	index, found := res.Indices["twitter"]
+	if !found {
+		t.Fatalf("expected health of index %q; got: %v", "twitter", res.Indices)
+	}
+	if index.NumberOfReplicas != 1 {
+		t.Errorf("expected NumberOfReplicas = %d; got: %d", 1, index.NumberOfReplicas)
+	}
+	if index.UnassignedShards != 1 {
+		t.Errorf("expected UnassignedShards = %d; got: %d", 1, index.UnassignedShards)
+	}
+	shard, found := index.Shards["0"]
+	if !found {
+		t.Fatalf("expected health of shard %q; got: %v", "0", index.Shards)
+	}
+	if !shard.PrimaryActive {
+		t.Errorf("expected PrimaryActive = %v; got: %v", true, shard.PrimaryActive)
+	}
+	if shard.Status != "yellow" {
+		t.Errorf("expected Status = %q; got: %q", "yellow", shard.Status)
+	}
+}
+
 func TestClusterHealthWaitForStatus(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -13,7 +13,9 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
-// ClusterStateService returns the state of the cluster.
+// ClusterStateService returns the state of the cluster. The full state
+// can be huge, so use Metric(s) and Index/Indices to retrieve only the
+// parts you are interested in, e.g. Metric("metadata").
 // It is documented at http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/cluster-state.html.
 type ClusterStateService struct {
 	client        *Client
@@ -155,24 +157,27 @@ func (s *ClusterStateService) Do() (*ClusterStateResponse, error) {
 
 // ClusterStateResponse is the response of ClusterStateService.Do.
 type ClusterStateResponse struct {
-	ClusterName  string                               `json:"cluster_name"`
-	Version      int                                  `json:"version"`
-	MasterNode   string                               `json:"master_node"`
-	Blocks       map[string]interface{}               `json:"blocks"`
-	Nodes        map[string]*ClusterStateNode         `json:"nodes"`
-	Metadata     *ClusterStateMetadata                `json:"metadata"`
-	RoutingTable map[string]*ClusterStateRoutingTable `json:"routing_table"`
-	RoutingNodes *ClusterStateRoutingNode             `json:"routing_nodes"`
-	Allocations  []interface{}                        `json:"allocations"`
-	Customs      map[string]interface{}               `json:"customs"`
-}
-
+	ClusterName  string                       `json:"cluster_name"`
+	Version      int                          `json:"version"`
+	MasterNode   string                       `json:"master_node"`
+	Blocks       map[string]interface{}       `json:"blocks"`
+	Nodes        map[string]*ClusterStateNode `json:"nodes"`
+	Metadata     *ClusterStateMetadata        `json:"metadata"`
+	RoutingTable *ClusterStateRoutingTable    `json:"routing_table"`
+	RoutingNodes *ClusterStateRoutingNode     `json:"routing_nodes"`
+	Allocations  []interface{}                `json:"allocations"`
+	Customs      map[string]interface{}       `json:"customs"`
+}
+
+// ClusterStateMetadata is the metadata section of the cluster state,
+// returned for the "metadata" metric.
 type ClusterStateMetadata struct {
 	Templates    map[string]interface{} `json:"templates"`
 	Indices      map[string]interface{} `json:"indices"`
 	Repositories map[string]interface{} `json:"repositories"`
 }
 
+// ClusterStateNode is a node of the cluster, returned for the "nodes" metric.
 type ClusterStateNode struct {
 	Name             string                 `json:"name"`
 	TransportAddress string                 `json:"transport_address"`
@@ -187,10 +192,14 @@ type ClusterStateNode struct {
 	Index          string  `json:"index"`
 }
 
+// ClusterStateRoutingTable is the routing table of the cluster, returned
+// for the "routing_table" metric. Indices is keyed by index name.
 type ClusterStateRoutingTable struct {
 	Indices map[string]interface{} `json:"indices"`
 }
 
+// ClusterStateRoutingNode is the routing_nodes section of the cluster
+// state, returned for the "routing_table" metric.
 type ClusterStateRoutingNode struct {
 	Unassigned []interface{}          `json:"unassigned"`
 	Nodes      map[string]interface{} `json:"nodes"`
@@ -5,6 +5,7 @@
 package elastic
 
 import (
+	"encoding/json"
 	"net/url"
 	"testing"
 )
@@ -75,6 +76,13 @@ func TestClusterStateURLs(t *testing.T) {
 			ExpectedPath:   "/_cluster/state/nodes/twitter",
 			ExpectedParams: url.Values{"master_timeout": []string{"1s"}},
 		},
+		{
+			Service: &ClusterStateService{
+				indices: []string{},
+				metrics: []string{"metadata", "routing_table"},
+			},
+			ExpectedPath: "/_cluster/state/metadata%2Crouting_table/_all",
+		},
 	}
 
 	for _, test := range tests {
@@ -90,3 +98,44 @@ func TestClusterStateURLs(t *testing.T) {
 		}
 	}
 }
+
+func TestClusterStateResponseWithMetadataAndRoutingTable(t *testing.T) {
+	body := `{
+		"cluster_name":"elasticsearch",
+		"master_node":"xfGeT4iiQbCZ9fkTjoWvkQ",
+		"metadata":{
+			"templates":{},
+			"indices":{
+				"twitter":{"state":"open","settings":{},"mappings":{},"aliases":[]}
+			}
+		},
+		"routing_table":{
+			"indices":{
+				"twitter":{"shards":{"0":[{"state":"STARTED","primary":true,"node":"xfGeT4iiQbCZ9fkTjoWvkQ","relocating_node":null,"shard":0,"index":"twitter"}]}}
+			}
+		}
+	}`
+
+	var res ClusterStateResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.ClusterName != "elasticsearch" {
+		t.Errorf("expected ClusterName = %q; got: %q", "elasticsearch", res.ClusterName)
+	}
+	if res.MasterNode != "xfGeT4iiQbCZ9fkTjoWvkQ" {
+		t.Errorf("expected MasterNode = %q; got: %q", "xfGeT4iiQbCZ9fkTjoWvkQ", res.MasterNode)
+	}
+	if res.Metadata == nil {
+		t.Fatalf("expected Metadata != nil; got: %v", res.Metadata)
+	}
+	if _, found := res.Metadata.Indices["twitter"]; !found {
+		t.Errorf("expected metadata of index %q; got: %v", "twitter", res.Metadata.Indices)
+	}
+	if res.RoutingTable == nil {
+		t.Fatalf("expected RoutingTable != nil; got: %v", res.RoutingTable)
+	}
+	if _, found := res.RoutingTable.Indices["twitter"]; !found {
+		t.Errorf("expected routing table of index %q; got: %v", "twitter", res.RoutingTable.Indices)
+	}
+}
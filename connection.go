@@ -13,11 +13,12 @@ import (
 // conn represents a single connection to a node in a cluster.
 type conn struct {
 	sync.RWMutex
-	nodeID    string // node ID
-	url       string
-	failures  int
-	dead      bool
-	deadSince *time.Time
+	nodeID      string // node ID
+	url         string
+	failures    int
+	dead        bool
+	deadSince   *time.Time
+	lastFailure *time.Time
 }
 
 // newConn creates a new connection to the given URL.
@@ -59,14 +60,16 @@ func (c *conn) IsDead() bool {
 }
 
 // MarkAsDead marks this connection as dead, increments the failures
-// counter and stores the current time in dead since.
+// counter and stores the current time as the time of the last failure
+// (and in dead since on the first failure).
 func (c *conn) MarkAsDead() {
 	c.Lock()
 	c.dead = true
+	utcNow := time.Now().UTC()
 	if c.deadSince == nil {
-		utcNow := time.Now().UTC()
 		c.deadSince = &utcNow
 	}
+	c.lastFailure = &utcNow
 	c.failures += 1
 	c.Unlock()
 }
@@ -85,6 +88,26 @@ func (c *conn) MarkAsHealthy() {
 	c.Lock()
 	c.dead = false
 	c.deadSince = nil
+	c.lastFailure = nil
 	c.failures = 0
 	c.Unlock()
 }
+
+// IsRetryable returns true if this connection is dead, but its last
+// failure was long enough ago to try it again. The time to wait starts
+// at delay and doubles with every failure, but never exceeds maxDelay.
+func (c *conn) IsRetryable(delay, maxDelay time.Duration) bool {
+	c.RLock()
+	defer c.RUnlock()
+	if !c.dead || c.lastFailure == nil {
+		return false
+	}
+	wait := delay
+	for i := 1; i < c.failures && wait < maxDelay; i++ {
+		wait *= 2
+	}
+	if wait > maxDelay {
+		wait = maxDelay
+	}
+	return time.Now().UTC().Sub(*c.lastFailure) >= wait
+}
@@ -0,0 +1,72 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConnIsRetryable(t *testing.T) {
+	c := newConn("node1", "http://127.0.0.1:9200")
+	if c.IsRetryable(time.Second, time.Minute) {
+		t.Fatal("expected a healthy connection not to be retryable")
+	}
+
+	c.MarkAsDead()
+	if c.IsRetryable(time.Second, time.Minute) {
+		t.Fatal("expected a connection that just died not to be retryable")
+	}
+	if !c.IsRetryable(0, time.Minute) {
+		t.Fatal("expected a dead connection to be retryable without delay")
+	}
+
+	// Pretend the connection failed 3 seconds ago
+	lastFailure := time.Now().UTC().Add(-3 * time.Second)
+	c.lastFailure = &lastFailure
+	if !c.IsRetryable(time.Second, time.Minute) {
+		t.Fatal("expected a dead connection to be retryable after 1s with 1 failure")
+	}
+
+	// With 3 failures, we wait 4 seconds
+	c.MarkAsDead()
+	c.MarkAsDead()
+	c.lastFailure = &lastFailure
+	if c.IsRetryable(time.Second, time.Minute) {
+		t.Fatal("expected a dead connection not to be retryable after 3s with 3 failures")
+	}
+	// ... unless the maximum delay is shorter
+	if !c.IsRetryable(time.Second, 2*time.Second) {
+		t.Fatal("expected a dead connection to be retryable after the maximum delay")
+	}
+
+	c.MarkAsHealthy()
+	if c.IsRetryable(0, time.Minute) {
+		t.Fatal("expected a healthy connection not to be retryable")
+	}
+}
+
+func TestConnIsRetryableAfterRepeatedFailures(t *testing.T) {
+	c := newConn("node1", "http://127.0.0.1:9200")
+
+	// The connection died a while ago, longer than the maximum delay
+	c.MarkAsDead()
+	lastFailure := time.Now().UTC().Add(-10 * time.Second)
+	c.deadSince = &lastFailure
+	c.lastFailure = &lastFailure
+	if !c.IsRetryable(time.Second, 5*time.Second) {
+		t.Fatal("expected a dead connection to be retryable after the maximum delay")
+	}
+
+	// Retrying fails again: we need to wait from this failure on,
+	// not from the time it died first
+	c.MarkAsDead()
+	if c.IsRetryable(time.Second, 5*time.Second) {
+		t.Fatal("expected a connection that just failed again not to be retryable")
+	}
+	if !c.deadSince.Equal(lastFailure) {
+		t.Errorf("expected dead since to remain %v; got: %v", lastFailure, c.deadSince)
+	}
+}
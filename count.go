@@ -28,12 +28,13 @@ type CountService struct {
 // (http://www.elasticsearch.org/guide/reference/api/count/)
 type CountResult struct {
 	Count  int64      `json:"count"`
-	Shards shardsInfo `json:"_shards,omitempty"`
+	Shards ShardsInfo `json:"_shards,omitempty"`
 }
 
 func NewCountService(client *Client) *CountService {
 	builder := &CountService{
 		client: client,
+		query:  NewMatchAllQuery(),
 	}
 	return builder
 }
@@ -70,6 +71,8 @@ func (s *CountService) Types(types ...string) *CountService {
 	return s
 }
 
+// Query restricts the count to documents matching the query.
+// All documents are counted by default (i.e. with a MatchAllQuery).
 func (s *CountService) Query(query Query) *CountService {
 	s.query = query
 	return s
@@ -80,6 +83,16 @@ func (s *CountService) Pretty(pretty bool) *CountService {
 	return s
 }
 
+// body returns the body of the request, which is nil if no query is set.
+func (s *CountService) body() interface{} {
+	if s.query == nil {
+		return nil
+	}
+	body := make(map[string]interface{})
+	body["query"] = s.query.Source()
+	return body
+}
+
 func (s *CountService) Do() (int64, error) {
 	var err error
 
@@ -125,16 +138,8 @@ func (s *CountService) Do() (int64, error) {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
 
-	// Set body if there is a query specified
-	var body interface{}
-	if s.query != nil {
-		query := make(map[string]interface{})
-		query["query"] = s.query.Source()
-		body = query
-	}
-
 	// Get response
-	res, err := s.client.PerformRequest("POST", path, params, body)
+	res, err := s.client.PerformRequest("POST", path, params, s.body())
 	if err != nil {
 		return 0, err
 	}
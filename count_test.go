@@ -4,7 +4,10 @@
 
 package elastic
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestCount(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
@@ -81,3 +84,30 @@ func TestCount(t *testing.T) {
 		t.Errorf("expected Count = %d; got %d", 2, count)
 	}
 }
+
+func TestCountSource(t *testing.T) {
+	tests := []struct {
+		Service  *CountService
+		Expected string
+	}{
+		{
+			NewCountService(nil),
+			`{"query":{"match_all":{}}}`,
+		},
+		{
+			NewCountService(nil).Query(NewTermQuery("user", "olivere")),
+			`{"query":{"term":{"user":"olivere"}}}`,
+		},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.Service.body())
+		if err != nil {
+			t.Fatalf("marshaling to JSON failed: %v", err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("expected\n%s\n,got:\n%s", test.Expected, got)
+		}
+	}
+}
@@ -6,7 +6,6 @@ package elastic
 
 import (
 	"encoding/json"
-	"errors"
 	"net/url"
 
 	"github.com/olivere/elastic/uritemplates"
@@ -46,11 +45,14 @@ func (s *CreateIndexService) MasterTimeout(masterTimeout string) *CreateIndexSer
 	return s
 }
 
-// Body specifies the configuration of the index as a string.
-// It is an alias for BodyString.
-func (b *CreateIndexService) Body(body string) *CreateIndexService {
-	b.bodyString = body
-	return b
+// Body specifies the settings and mappings of the index, either as
+// a JSON-encoded string or as a value that is serialized as JSON,
+// e.g. a map[string]interface{}.
+func (b *CreateIndexService) Body(body interface{}) *CreateIndexService {
+	if s, ok := body.(string); ok {
+		return b.BodyString(s)
+	}
+	return b.BodyJson(body)
 }
 
 // BodyString specifies the configuration of the index as a string.
@@ -72,10 +74,11 @@ func (b *CreateIndexService) Pretty(pretty bool) *CreateIndexService {
 	return b
 }
 
-// Do executes the operation.
+// Do executes the operation. It returns ErrIndexAlreadyExists
+// if the index already exists.
 func (b *CreateIndexService) Do() (*CreateIndexResult, error) {
 	if b.index == "" {
-		return nil, errors.New("missing index name")
+		return nil, ErrMissingIndex
 	}
 
 	// Build url
@@ -108,6 +111,9 @@ func (b *CreateIndexService) Do() (*CreateIndexResult, error) {
 	// Get response
 	res, err := b.client.PerformRequest("PUT", path, params, body)
 	if err != nil {
+		if isIndexAlreadyExists(err) {
+			return nil, ErrIndexAlreadyExists
+		}
 		return nil, err
 	}
 
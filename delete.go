@@ -12,17 +12,22 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// DeleteService deletes a single document by its index, type, and id.
+// See http://www.elastic.co/guide/en/elasticsearch/reference/current/docs-delete.html
+// for details.
 type DeleteService struct {
-	client  *Client
-	index   string
-	_type   string
-	id      string
-	routing string
-	refresh *bool
-	version *int
-	pretty  bool
+	client      *Client
+	index       string
+	_type       string
+	id          string
+	routing     string
+	refresh     *bool
+	version     *int64
+	versionType string
+	pretty      bool
 }
 
+// NewDeleteService creates a new DeleteService.
 func NewDeleteService(client *Client) *DeleteService {
 	builder := &DeleteService{
 		client: client,
@@ -45,6 +50,8 @@ func (s *DeleteService) Id(id string) *DeleteService {
 	return s
 }
 
+// Parent sets the id of the parent document. It is used as routing
+// unless Routing is set explicitly.
 func (s *DeleteService) Parent(parent string) *DeleteService {
 	if s.routing == "" {
 		s.routing = parent
@@ -52,34 +59,39 @@ func (s *DeleteService) Parent(parent string) *DeleteService {
 	return s
 }
 
+// Routing sets the routing value of the document.
+func (s *DeleteService) Routing(routing string) *DeleteService {
+	s.routing = routing
+	return s
+}
+
+// Refresh the index after performing the operation.
 func (s *DeleteService) Refresh(refresh bool) *DeleteService {
 	s.refresh = &refresh
 	return s
 }
 
-func (s *DeleteService) Version(version int) *DeleteService {
+// Version sets the expected version of the document. Deleting fails
+// with a conflict if the document has a different version.
+func (s *DeleteService) Version(version int64) *DeleteService {
 	s.version = &version
 	return s
 }
 
+// VersionType is either "internal" (default), "external",
+// "external_gt", "external_gte", or "force".
+func (s *DeleteService) VersionType(versionType string) *DeleteService {
+	s.versionType = versionType
+	return s
+}
+
 func (s *DeleteService) Pretty(pretty bool) *DeleteService {
 	s.pretty = pretty
 	return s
 }
 
-// Do deletes the document. It fails if any of index, type, and identifier
-// are missing.
-func (s *DeleteService) Do() (*DeleteResult, error) {
-	if s.index == "" {
-		return nil, ErrMissingIndex
-	}
-	if s._type == "" {
-		return nil, ErrMissingType
-	}
-	if s.id == "" {
-		return nil, ErrMissingId
-	}
-
+// buildURL builds the URL for the operation.
+func (s *DeleteService) buildURL() (string, url.Values, error) {
 	// Build url
 	path, err := uritemplates.Expand("/{index}/{type}/{id}", map[string]string{
 		"index": s.index,
@@ -87,7 +99,7 @@ func (s *DeleteService) Do() (*DeleteResult, error) {
 		"id":    s.id,
 	})
 	if err != nil {
-		return nil, err
+		return "", url.Values{}, err
 	}
 
 	// Parameters
@@ -98,12 +110,36 @@ func (s *DeleteService) Do() (*DeleteResult, error) {
 	if s.version != nil {
 		params.Set("version", fmt.Sprintf("%d", *s.version))
 	}
+	if s.versionType != "" {
+		params.Set("version_type", s.versionType)
+	}
 	if s.routing != "" {
 		params.Set("routing", fmt.Sprintf("%s", s.routing))
 	}
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
+	return path, params, nil
+}
+
+// Do deletes the document. It fails if any of index, type, and identifier
+// are missing. If the document is not found, Do returns no error but
+// a DeleteResult with Found set to false.
+func (s *DeleteService) Do() (*DeleteResult, error) {
+	if s.index == "" {
+		return nil, ErrMissingIndex
+	}
+	if s._type == "" {
+		return nil, ErrMissingType
+	}
+	if s.id == "" {
+		return nil, ErrMissingId
+	}
+
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := s.client.PerformRequest("DELETE", path, params, nil)
@@ -121,6 +157,7 @@ func (s *DeleteService) Do() (*DeleteResult, error) {
 
 // -- Result of a delete request.
 
+// DeleteResult is the outcome of DeleteService.Do.
 type DeleteResult struct {
 	Found   bool   `json:"found"`
 	Index   string `json:"_index"`
@@ -15,6 +15,18 @@ import (
 
 // DeleteByQueryService deletes documents that match a query.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/master/docs-delete-by-query.html.
+//
+// By default, it uses the DELETE /{index}/{type}/_query endpoint of
+// Elasticsearch 1.x (and the delete-by-query plugin of 2.x). Use
+// TaskBased for the POST /{index}/{type}/_delete_by_query endpoint
+// of Elasticsearch 5.0 and later.
+//
+// Example: Delete all tweets older than 30 days.
+//
+//	res, err := client.DeleteByQuery("twitter").
+//	  Type("tweet").
+//	  Query(elastic.NewRangeQuery("created").Lt("now-30d")).
+//	  Do()
 type DeleteByQueryService struct {
 	client            *Client
 	indices           []string
@@ -32,6 +44,9 @@ type DeleteByQueryService struct {
 	pretty            bool
 	q                 string
 	query             Query
+	taskBased         bool
+	conflicts         string
+	waitForCompletion *bool
 }
 
 // NewDeleteByQueryService creates a new DeleteByQueryService.
@@ -179,8 +194,36 @@ func (s *DeleteByQueryService) Query(query Query) *DeleteByQueryService {
 	return s
 }
 
-// Do executes the delete-by-query operation.
-func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
+// TaskBased uses the POST /{index}/{type}/_delete_by_query endpoint of
+// Elasticsearch 5.0 and later instead of DELETE /{index}/{type}/_query.
+func (s *DeleteByQueryService) TaskBased(taskBased bool) *DeleteByQueryService {
+	s.taskBased = taskBased
+	return s
+}
+
+// Conflicts specifies what to do on version conflicts: "abort" (default)
+// or "proceed". It is only used with TaskBased.
+func (s *DeleteByQueryService) Conflicts(conflicts string) *DeleteByQueryService {
+	s.conflicts = conflicts
+	return s
+}
+
+// ProceedOnVersionConflict is a shortcut for Conflicts("proceed").
+func (s *DeleteByQueryService) ProceedOnVersionConflict() *DeleteByQueryService {
+	s.conflicts = "proceed"
+	return s
+}
+
+// WaitForCompletion indicates whether to wait for the operation to
+// complete (default: true). If false, Elasticsearch returns a Task that
+// can be used to follow the operation. It is only used with TaskBased.
+func (s *DeleteByQueryService) WaitForCompletion(waitForCompletion bool) *DeleteByQueryService {
+	s.waitForCompletion = &waitForCompletion
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *DeleteByQueryService) buildURL() (string, url.Values, error) {
 	var err error
 
 	// Build url
@@ -193,12 +236,14 @@ func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
 			"index": index,
 		})
 		if err != nil {
-			return nil, err
+			return "", url.Values{}, err
 		}
 		indexPart = append(indexPart, index)
 	}
 	if len(indexPart) > 0 {
 		path += strings.Join(indexPart, ",")
+	} else if len(s.types) > 0 || s.taskBased {
+		path += "_all"
 	}
 
 	// Types part
@@ -208,7 +253,7 @@ func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
 			"type": typ,
 		})
 		if err != nil {
-			return nil, err
+			return "", url.Values{}, err
 		}
 		typesPart = append(typesPart, typ)
 	}
@@ -217,7 +262,13 @@ func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
 	}
 
 	// Search
-	path += "/_query"
+	if s.taskBased {
+		path += "/_delete_by_query"
+	} else if strings.HasSuffix(path, "/") {
+		path += "_query"
+	} else {
+		path += "/_query"
+	}
 
 	// Parameters
 	params := make(url.Values)
@@ -257,17 +308,39 @@ func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
 	if s.q != "" {
 		params.Set("q", s.q)
 	}
+	if s.conflicts != "" {
+		params.Set("conflicts", s.conflicts)
+	}
+	if s.waitForCompletion != nil {
+		params.Set("wait_for_completion", fmt.Sprintf("%v", *s.waitForCompletion))
+	}
+	return path, params, nil
+}
 
-	// Set body if there is a query set
-	var body interface{}
-	if s.query != nil {
-		query := make(map[string]interface{})
-		query["query"] = s.query.Source()
-		body = query
+// body returns the body of the operation, or nil if no query is set.
+func (s *DeleteByQueryService) body() interface{} {
+	if s.query == nil {
+		return nil
+	}
+	query := make(map[string]interface{})
+	query["query"] = s.query.Source()
+	return query
+}
+
+// Do executes the delete-by-query operation.
+func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
 	}
 
 	// Get response
-	res, err := s.client.PerformRequest("DELETE", path, params, body)
+	method := "DELETE"
+	if s.taskBased {
+		method = "POST"
+	}
+	res, err := s.client.PerformRequest(method, path, params, s.body())
 	if err != nil {
 		return nil, err
 	}
@@ -282,11 +355,31 @@ func (s *DeleteByQueryService) Do() (*DeleteByQueryResult, error) {
 
 // DeleteByQueryResult is the outcome of executing Do with DeleteByQueryService.
 type DeleteByQueryResult struct {
+	// Indices is returned by the _query endpoint, keyed by index name.
+	// The delete-by-query plugin of Elasticsearch 2.x adds an "_all" entry
+	// with the totals over all indices.
 	Indices map[string]IndexDeleteByQueryResult `json:"_indices"`
+
+	// The following fields are returned by the _delete_by_query endpoint
+	// (see TaskBased). Task is only set with WaitForCompletion(false).
+	Took             int64                    `json:"took"`
+	TimedOut         bool                     `json:"timed_out"`
+	Total            int64                    `json:"total"`
+	Deleted          int64                    `json:"deleted"`
+	Batches          int64                    `json:"batches"`
+	VersionConflicts int64                    `json:"version_conflicts"`
+	Noops            int64                    `json:"noops"`
+	Failures         []map[string]interface{} `json:"failures"`
+	Task             string                   `json:"task"`
 }
 
 // IndexDeleteByQueryResult is the result of a delete-by-query for a specific
-// index.
+// index. Found, Deleted, Missing, and Failed are only returned by the
+// delete-by-query plugin of Elasticsearch 2.x.
 type IndexDeleteByQueryResult struct {
-	Shards shardsInfo `json:"_shards"`
+	Shards  ShardsInfo `json:"_shards"`
+	Found   int64      `json:"found"`
+	Deleted int64      `json:"deleted"`
+	Missing int64      `json:"missing"`
+	Failed  int64      `json:"failed"`
 }
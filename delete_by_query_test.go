@@ -5,6 +5,11 @@
 package elastic
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"testing"
 )
 
@@ -74,3 +79,83 @@ func TestDeleteByQuery(t *testing.T) {
 		t.Fatalf("expected Count = %d; got: %d", 2, count)
 	}
 }
+
+func TestDeleteByQueryWithTypeButNoIndex(t *testing.T) {
+	path, _, err := NewDeleteByQueryService(nil).Type("tweet").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/_all/tweet/_query" {
+		t.Errorf("expected path %q; got: %q", "/_all/tweet/_query", path)
+	}
+}
+
+func TestDeleteByQueryTaskBased(t *testing.T) {
+	var method, path string
+	var params url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		method, path, params = r.Method, r.URL.Path, r.URL.Query()
+		fmt.Fprint(w, `{"took":147,"timed_out":false,"total":119,"deleted":119,"batches":1,"version_conflicts":0,"noops":0,"failures":[]}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	res, err := client.DeleteByQuery().Index("twitter").Type("tweet").
+		Query(NewTermQuery("user", "sandrae")).
+		TaskBased(true).
+		ProceedOnVersionConflict().
+		WaitForCompletion(false).
+		Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != "POST" {
+		t.Errorf("expected method %q; got: %q", "POST", method)
+	}
+	if path != "/twitter/tweet/_delete_by_query" {
+		t.Errorf("expected path %q; got: %q", "/twitter/tweet/_delete_by_query", path)
+	}
+	if got := params.Get("conflicts"); got != "proceed" {
+		t.Errorf("expected conflicts = %q; got: %q", "proceed", got)
+	}
+	if got := params.Get("wait_for_completion"); got != "false" {
+		t.Errorf("expected wait_for_completion = %q; got: %q", "false", got)
+	}
+	if res.Deleted != 119 {
+		t.Errorf("expected Deleted = %d; got: %d", 119, res.Deleted)
+	}
+
+	// Without indices, the task-based endpoint runs on all indices
+	path, _, err = NewDeleteByQueryService(nil).TaskBased(true).buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/_all/_delete_by_query" {
+		t.Errorf("expected path %q; got: %q", "/_all/_delete_by_query", path)
+	}
+}
+
+func TestDeleteByQueryBody(t *testing.T) {
+	if body := NewDeleteByQueryService(nil).body(); body != nil {
+		t.Errorf("expected no body without query; got: %v", body)
+	}
+
+	s := NewDeleteByQueryService(nil).Query(NewRangeQuery("created").Lt("now-30d"))
+	data, err := json.Marshal(s.body())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"range":{"created":{"include_lower":true,"include_upper":false,"to":"now-30d"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
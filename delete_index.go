@@ -10,11 +10,13 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// DeleteIndexService deletes an index.
 type DeleteIndexService struct {
 	client *Client
 	index  string
 }
 
+// NewDeleteIndexService creates a new DeleteIndexService.
 func NewDeleteIndexService(client *Client) *DeleteIndexService {
 	builder := &DeleteIndexService{
 		client: client,
@@ -22,12 +24,19 @@ func NewDeleteIndexService(client *Client) *DeleteIndexService {
 	return builder
 }
 
+// Index is the name of the index to delete.
 func (b *DeleteIndexService) Index(index string) *DeleteIndexService {
 	b.index = index
 	return b
 }
 
+// Do executes the operation. It fails with ErrMissingIndex if no index
+// is given because Elasticsearch would delete all indices otherwise.
 func (b *DeleteIndexService) Do() (*DeleteIndexResult, error) {
+	if b.index == "" {
+		return nil, ErrMissingIndex
+	}
+
 	// Build url
 	path, err := uritemplates.Expand("/{index}/", map[string]string{
 		"index": b.index,
@@ -52,6 +61,7 @@ func (b *DeleteIndexService) Do() (*DeleteIndexResult, error) {
 
 // -- Result of a delete index request.
 
+// DeleteIndexResult is the outcome of deleting an index.
 type DeleteIndexResult struct {
 	Acknowledged bool `json:"acknowledged"`
 }
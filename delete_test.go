@@ -113,3 +113,23 @@ func TestDeleteWithEmptyIDFails(t *testing.T) {
 		t.Fatalf("expected to not accept delete without index, got: %v", err)
 	}
 }
+
+func TestDeleteWithRoutingAndVersionType(t *testing.T) {
+	_, params, err := NewDeleteService(nil).Index("twitter").Type("tweet").Id("1").
+		Routing("kimchy").
+		Version(2).
+		VersionType("external").
+		buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := params.Get("routing"); got != "kimchy" {
+		t.Errorf("expected routing %q; got: %q", "kimchy", got)
+	}
+	if got := params.Get("version"); got != "2" {
+		t.Errorf("expected version %q; got: %q", "2", got)
+	}
+	if got := params.Get("version_type"); got != "external" {
+		t.Errorf("expected version_type %q; got: %q", "external", got)
+	}
+}
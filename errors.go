@@ -10,6 +10,8 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"net/url"
+	"strings"
 )
 
 var (
@@ -21,34 +23,156 @@ var (
 
 	// ErrMissingId is returned e.g. from DeleteService if the document identifier is missing.
 	ErrMissingId = errors.New("elastic: id is missing")
+
+	// ErrIndexAlreadyExists is returned from CreateIndexService if the index already exists.
+	ErrIndexAlreadyExists = errors.New("elastic: index already exists")
 )
 
+// checkResponse returns an error if res is an error response from
+// Elasticsearch, i.e. if its status code is neither 2xx nor 404.
+// The error is an *Error decoded from the response body.
 func checkResponse(res *http.Response) error {
 	// 200-299 and 404 are valid status codes
 	if (res.StatusCode >= 200 && res.StatusCode <= 299) || res.StatusCode == http.StatusNotFound {
 		return nil
 	}
 	if res.Body == nil {
-		return fmt.Errorf("elastic: Error %d (%s)", res.StatusCode, http.StatusText(res.StatusCode))
+		return &Error{Status: res.StatusCode}
 	}
 	slurp, err := ioutil.ReadAll(res.Body)
 	if err != nil {
 		return fmt.Errorf("elastic: Error %d (%s) when reading body: %v", res.StatusCode, http.StatusText(res.StatusCode), err)
 	}
+	return createErrorFromBody(res.StatusCode, slurp)
+}
+
+// createErrorFromBody decodes the body of an error response with the
+// given status code into an *Error. The body needn't be JSON.
+func createErrorFromBody(statusCode int, body []byte) *Error {
 	errReply := new(Error)
-	err = json.Unmarshal(slurp, errReply)
-	if err == nil && errReply != nil {
-		if errReply.Status == 0 {
-			errReply.Status = res.StatusCode
-		}
-		return errReply
+	if err := json.Unmarshal(body, errReply); err != nil {
+		// Not a JSON error, e.g. from a proxy in front of Elasticsearch
+		errReply = new(Error)
 	}
-	return nil
+	if errReply.Status == 0 {
+		errReply.Status = statusCode
+	}
+	errReply.Body = body
+	return errReply
+}
+
+// isTransientError returns true if err is a connection error or an
+// *Error with a server error (5xx) or 429 Too Many Requests, i.e. if
+// sending the same request again later might succeed.
+func isTransientError(err error) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Status == http.StatusTooManyRequests || e.Status >= 500
+	}
+	var uerr *url.Error
+	return errors.Is(err, ErrNoClient) || errors.As(err, &uerr)
+}
+
+// IsEOS returns true if err signals the end of a scan or scroll,
+// i.e. if err is or wraps EOS.
+func IsEOS(err error) bool {
+	return errors.Is(err, EOS)
 }
 
+// IsNotFound returns true if err is or wraps an *Error with
+// status code 404 (Not Found).
+//
+// Notice that most calls don't return an error for 404: PerformRequest
+// treats it as a valid response, and services report a missing resource
+// in their result instead, e.g. GetResult.Found or the bool returned by
+// ExistsService and IndexExistsService. Only services that have no way
+// to report it otherwise return an *Error with status 404, which is
+// GetMappingService for a missing index or type.
+func IsNotFound(err error) bool {
+	return IsStatusCode(err, http.StatusNotFound)
+}
+
+// IsStatusCode returns true if err is or wraps an *Error with the
+// given HTTP status code, e.g. http.StatusConflict.
+func IsStatusCode(err error, code int) bool {
+	var e *Error
+	if errors.As(err, &e) {
+		return e.Status == code
+	}
+	return false
+}
+
+// isIndexAlreadyExists returns true if err is the response of
+// Elasticsearch to creating an index that already exists.
+func isIndexAlreadyExists(err error) bool {
+	e, ok := err.(*Error)
+	if !ok || e.Status != http.StatusBadRequest {
+		return false
+	}
+	return strings.Contains(e.Message, "IndexAlreadyExistsException") ||
+		strings.Contains(e.Message, "index_already_exists_exception") ||
+		strings.Contains(e.Message, "resource_already_exists_exception")
+}
+
+// Error is an error returned from Elasticsearch.
 type Error struct {
-	Status  int    `json:"status"`
+	Status int `json:"status"`
+	// Message is the error message. As of Elasticsearch 2.0, it is
+	// built from the type and reason of Details.
 	Message string `json:"error"`
+	// Details contains the structured error returned by
+	// Elasticsearch 2.0 and later. It is nil with earlier versions.
+	Details *ErrorDetails `json:"-"`
+	// Body is the raw body of the error response, e.g. for logging.
+	Body []byte `json:"-"`
+}
+
+// ErrorDetails describes the cause of an error returned by
+// Elasticsearch 2.0 and later.
+type ErrorDetails struct {
+	Type         string                   `json:"type"`
+	Reason       string                   `json:"reason"`
+	ResourceType string                   `json:"resource.type,omitempty"`
+	ResourceId   interface{}              `json:"resource.id,omitempty"`
+	Index        string                   `json:"index,omitempty"`
+	Phase        string                   `json:"phase,omitempty"`
+	Grouped      bool                     `json:"grouped,omitempty"`
+	CausedBy     map[string]interface{}   `json:"caused_by,omitempty"`
+	RootCause    []*ErrorDetails          `json:"root_cause,omitempty"`
+	FailedShards []map[string]interface{} `json:"failed_shards,omitempty"`
+}
+
+// UnmarshalJSON decodes both the error responses of Elasticsearch 1.x,
+// e.g. {"error":"IndexMissingException[...]","status":404}, and the
+// structured ones of Elasticsearch 2.0 and later, e.g.
+// {"error":{"type":"index_not_found_exception","reason":"..."},"status":404}.
+func (e *Error) UnmarshalJSON(data []byte) error {
+	var reply struct {
+		Status int             `json:"status"`
+		Error  json.RawMessage `json:"error"`
+	}
+	if err := json.Unmarshal(data, &reply); err != nil {
+		return err
+	}
+	if reply.Status != 0 {
+		e.Status = reply.Status
+	}
+	if len(reply.Error) == 0 || reply.Error[0] != '{' {
+		if len(reply.Error) > 0 {
+			return json.Unmarshal(reply.Error, &e.Message)
+		}
+		return nil
+	}
+	details := new(ErrorDetails)
+	if err := json.Unmarshal(reply.Error, details); err != nil {
+		return err
+	}
+	e.Details = details
+	e.Message = details.Type
+	if details.Reason != "" {
+		e.Message += ": " + details.Reason
+	}
+	return nil
 }
 
 func (e *Error) Error() string {
@@ -58,3 +182,30 @@ func (e *Error) Error() string {
 		return fmt.Sprintf("elastic: Error %d (%s)", e.Status, http.StatusText(e.Status))
 	}
 }
+
+// ShardFailuresError is returned e.g. from SearchService if the operation
+// failed on some shards and the service was told to fail in this case,
+// e.g. with SearchService.FailOnShardFailures. Elasticsearch still
+// returns the results of the other shards, but they are incomplete.
+type ShardFailuresError struct {
+	Total    int
+	Failed   int
+	Failures []*ShardFailure
+}
+
+func (e *ShardFailuresError) Error() string {
+	return fmt.Sprintf("elastic: operation failed on %d of %d shards", e.Failed, e.Total)
+}
+
+// checkShardFailures returns a *ShardFailuresError if shards reports
+// any failed shards, and nil otherwise.
+func checkShardFailures(shards *ShardsInfo) error {
+	if shards == nil || shards.Failed == 0 {
+		return nil
+	}
+	return &ShardFailuresError{
+		Total:    shards.Total,
+		Failed:   shards.Failed,
+		Failures: shards.Failures,
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bufio"
 	"fmt"
 	"net/http"
+	"net/http/httptest"
 	"strings"
 	"testing"
 )
@@ -43,3 +44,162 @@ func TestResponseError(t *testing.T) {
 		t.Fatalf("expected error message %q; got: %q", message, e.Message)
 	}
 }
+
+func TestResponseErrorIndexAlreadyExists(t *testing.T) {
+	raw := "HTTP/1.1 400 Bad Request\r\n" +
+		"\r\n" +
+		`{"error":"IndexAlreadyExistsException[[twitter] already exists]","status":400}` + "\r\n"
+	r := bufio.NewReader(strings.NewReader(raw))
+
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkResponse(resp)
+	if err == nil {
+		t.Fatalf("expected error; got: %v", err)
+	}
+	if !isIndexAlreadyExists(err) {
+		t.Errorf("expected %v to be an index already exists error", err)
+	}
+	if isIndexAlreadyExists(&Error{Status: 500, Message: "Something went seriously wrong."}) {
+		t.Errorf("expected other errors not to be index already exists errors")
+	}
+}
+
+func TestResponseErrorWithDetails(t *testing.T) {
+	raw := "HTTP/1.1 400 Bad Request\r\n" +
+		"\r\n" +
+		`{"error":{"root_cause":[{"type":"illegal_argument_exception","reason":"mapper [user] of different type, current_type [string], merged_type [long]"}],"type":"illegal_argument_exception","reason":"mapper [user] of different type, current_type [string], merged_type [long]"},"status":400}` + "\r\n"
+	r := bufio.NewReader(strings.NewReader(raw))
+
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkResponse(resp)
+	if err == nil {
+		t.Fatalf("expected error; got: %v", err)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatal("expected error to be of type *elastic.Error")
+	}
+	if e.Status != http.StatusBadRequest {
+		t.Errorf("expected status code %d; got: %d", http.StatusBadRequest, e.Status)
+	}
+	if e.Details == nil {
+		t.Fatal("expected error details; got nil")
+	}
+	if e.Details.Type != "illegal_argument_exception" {
+		t.Errorf("expected error type %q; got: %q", "illegal_argument_exception", e.Details.Type)
+	}
+	if len(e.Details.RootCause) != 1 {
+		t.Fatalf("expected %d root cause; got: %d", 1, len(e.Details.RootCause))
+	}
+	expected := "illegal_argument_exception: mapper [user] of different type, current_type [string], merged_type [long]"
+	if e.Message != expected {
+		t.Errorf("expected error message %q; got: %q", expected, e.Message)
+	}
+	if len(e.Body) == 0 {
+		t.Errorf("expected body to be available")
+	}
+}
+
+func TestResponseErrorWithoutJSONBody(t *testing.T) {
+	raw := "HTTP/1.1 502 Bad Gateway\r\n" +
+		"\r\n" +
+		"<html><body>Bad Gateway</body></html>\r\n"
+	r := bufio.NewReader(strings.NewReader(raw))
+
+	resp, err := http.ReadResponse(r, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = checkResponse(resp)
+	if err == nil {
+		t.Fatalf("expected error; got: %v", err)
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatal("expected error to be of type *elastic.Error")
+	}
+	if e.Status != http.StatusBadGateway {
+		t.Errorf("expected status code %d; got: %d", http.StatusBadGateway, e.Status)
+	}
+	if !strings.Contains(string(e.Body), "Bad Gateway") {
+		t.Errorf("expected body to be available; got: %q", e.Body)
+	}
+}
+
+func TestIsEOS(t *testing.T) {
+	if !IsEOS(EOS) {
+		t.Errorf("expected EOS to be EOS")
+	}
+	if !IsEOS(fmt.Errorf("scrolling failed: %w", EOS)) {
+		t.Errorf("expected wrapped EOS to be EOS")
+	}
+	if IsEOS(ErrNoScrollId) {
+		t.Errorf("expected %v not to be EOS", ErrNoScrollId)
+	}
+	if IsEOS(nil) {
+		t.Errorf("expected nil not to be EOS")
+	}
+}
+
+func TestIsNotFoundAndIsStatusCode(t *testing.T) {
+	tests := []struct {
+		Err      error
+		Code     int
+		Expected bool
+	}{
+		{&Error{Status: http.StatusNotFound}, http.StatusNotFound, true},
+		{fmt.Errorf("get failed: %w", &Error{Status: http.StatusNotFound}), http.StatusNotFound, true},
+		{&Error{Status: http.StatusConflict}, http.StatusConflict, true},
+		{&Error{Status: http.StatusConflict}, http.StatusNotFound, false},
+		{EOS, http.StatusNotFound, false},
+		{nil, http.StatusNotFound, false},
+	}
+	for _, test := range tests {
+		if got := IsStatusCode(test.Err, test.Code); got != test.Expected {
+			t.Errorf("expected IsStatusCode(%v, %d) = %v; got: %v", test.Err, test.Code, test.Expected, got)
+		}
+		if test.Code == http.StatusNotFound {
+			if got := IsNotFound(test.Err); got != test.Expected {
+				t.Errorf("expected IsNotFound(%v) = %v; got: %v", test.Err, test.Expected, got)
+			}
+		}
+	}
+}
+
+func TestIsNotFoundFromResponse(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"error":"IndexMissingException[[twitter] missing]","status":404}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// PerformRequest treats 404 as a valid response
+	res, err := client.PerformRequest("GET", "/twitter/_mapping", nil, nil)
+	if err != nil {
+		t.Fatalf("expected no error for 404; got: %v", err)
+	}
+	if res.StatusCode != http.StatusNotFound {
+		t.Errorf("expected status code %d; got: %d", http.StatusNotFound, res.StatusCode)
+	}
+
+	// GetMapping returns a 404 *Error
+	_, err = client.GetMapping().Index("twitter").Do()
+	if !IsNotFound(err) {
+		t.Errorf("expected IsNotFound(%v) = true; got: false", err)
+	}
+}
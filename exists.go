@@ -6,20 +6,31 @@ package elastic
 
 import (
 	"fmt"
+	"net/url"
 
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// ExistsService checks if a document exists without fetching it.
+// The type defaults to "_all".
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/docs-get.html.
 type ExistsService struct {
-	client *Client
-	index  string
-	_type  string
-	id     string
+	client     *Client
+	index      string
+	_type      string
+	id         string
+	routing    string
+	parent     string
+	preference string
+	realtime   *bool
+	refresh    *bool
 }
 
+// NewExistsService creates a new ExistsService.
 func NewExistsService(client *Client) *ExistsService {
 	builder := &ExistsService{
 		client: client,
+		_type:  "_all",
 	}
 	return builder
 }
@@ -31,34 +42,127 @@ func (s *ExistsService) String() string {
 		s.id)
 }
 
+// Index is the name of the index.
 func (s *ExistsService) Index(index string) *ExistsService {
 	s.index = index
 	return s
 }
 
+// Type is the type of the document (use "_all" to fetch the first
+// document matching the id across all types).
 func (s *ExistsService) Type(_type string) *ExistsService {
 	s._type = _type
 	return s
 }
 
+// Id is the document id.
 func (s *ExistsService) Id(id string) *ExistsService {
 	s.id = id
 	return s
 }
 
-func (s *ExistsService) Do() (bool, error) {
-	// Build url
+// Routing is a specific routing value.
+func (s *ExistsService) Routing(routing string) *ExistsService {
+	s.routing = routing
+	return s
+}
+
+// Parent is the id of the parent document. It is used as routing
+// unless Routing is set explicitly.
+func (s *ExistsService) Parent(parent string) *ExistsService {
+	s.parent = parent
+	return s
+}
+
+// Preference specifies the node or shard the operation should be
+// performed on (default: random).
+func (s *ExistsService) Preference(preference string) *ExistsService {
+	s.preference = preference
+	return s
+}
+
+// Realtime specifies whether to perform the operation in realtime
+// or search mode.
+func (s *ExistsService) Realtime(realtime bool) *ExistsService {
+	s.realtime = &realtime
+	return s
+}
+
+// Refresh the shard containing the document before performing the operation.
+func (s *ExistsService) Refresh(refresh bool) *ExistsService {
+	s.refresh = &refresh
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ExistsService) buildURL() (string, url.Values, error) {
+	// Build URL
 	path, err := uritemplates.Expand("/{index}/{type}/{id}", map[string]string{
 		"index": s.index,
 		"type":  s._type,
 		"id":    s.id,
 	})
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	} else if s.parent != "" {
+		params.Set("routing", s.parent)
+	}
+	if s.parent != "" {
+		params.Set("parent", s.parent)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
+	if s.realtime != nil {
+		params.Set("realtime", fmt.Sprintf("%v", *s.realtime))
+	}
+	if s.refresh != nil {
+		params.Set("refresh", fmt.Sprintf("%v", *s.refresh))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *ExistsService) Validate() error {
+	var invalid []string
+	if s.index == "" {
+		invalid = append(invalid, "Index")
+	}
+	if s._type == "" {
+		invalid = append(invalid, "Type")
+	}
+	if s.id == "" {
+		invalid = append(invalid, "Id")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation. It returns true if Elasticsearch responds
+// with 200 and false if it responds with 404. Other status codes
+// result in an error.
+func (s *ExistsService) Do() (bool, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return false, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
 	if err != nil {
 		return false, err
 	}
 
 	// Get response
-	res, err := s.client.PerformRequest("HEAD", path, nil, nil)
+	res, err := s.client.PerformRequest("HEAD", path, params, nil)
 	if err != nil {
 		return false, err
 	}
@@ -0,0 +1,104 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestExistsBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *ExistsService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewExistsService(nil).Index("twitter").Id("1"),
+			"/twitter/_all/1",
+			"",
+		},
+		{
+			NewExistsService(nil).Index("twitter").Type("tweet").Id("1").Routing("kimchy"),
+			"/twitter/tweet/1",
+			"routing=kimchy",
+		},
+		{
+			NewExistsService(nil).Index("twitter").Type("comment").Id("2").Parent("1"),
+			"/twitter/comment/2",
+			"parent=1&routing=1",
+		},
+		{
+			NewExistsService(nil).Index("twitter").Type("tweet").Id("1").Preference("_local").Realtime(false).Refresh(true),
+			"/twitter/tweet/1",
+			"preference=_local&realtime=false&refresh=true",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestExistsValidate(t *testing.T) {
+	if err := NewExistsService(nil).Index("twitter").Validate(); err == nil {
+		t.Errorf("expected Validate to fail without Id")
+	}
+	if err := NewExistsService(nil).Type("tweet").Id("1").Validate(); err == nil {
+		t.Errorf("expected Validate to fail without Index")
+	}
+	if err := NewExistsService(nil).Index("twitter").Id("1").Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+}
+
+func TestExistsDo(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != "HEAD" {
+			t.Errorf("expected HTTP method %q; got: %q", "HEAD", r.Method)
+		}
+		switch r.URL.Path {
+		case "/", "/twitter/tweet/1":
+			w.WriteHeader(http.StatusOK)
+		case "/twitter/tweet/2":
+			w.WriteHeader(http.StatusNotFound)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+
+	exists, err := client.Exists().Index("twitter").Type("tweet").Id("1").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !exists {
+		t.Errorf("expected document %q to exist", "1")
+	}
+
+	exists, err = client.Exists().Index("twitter").Type("tweet").Id("2").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exists {
+		t.Errorf("expected document %q to not exist", "2")
+	}
+}
@@ -327,3 +327,21 @@ type ExplainResponse struct {
 	Matched     bool                   `json:"matched"`
 	Explanation map[string]interface{} `json:"explanation"`
 }
+
+// SearchExplanation returns Explanation decoded into a SearchExplanation,
+// i.e. how the score was computed. It returns nil if there is no
+// explanation, e.g. if the document doesn't match.
+func (r *ExplainResponse) SearchExplanation() (*SearchExplanation, error) {
+	if r.Explanation == nil {
+		return nil, nil
+	}
+	data, err := json.Marshal(r.Explanation)
+	if err != nil {
+		return nil, err
+	}
+	expl := new(SearchExplanation)
+	if err := json.Unmarshal(data, expl); err != nil {
+		return nil, err
+	}
+	return expl, nil
+}
@@ -4,7 +4,10 @@
 
 package elastic
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestExplain(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
@@ -39,3 +42,49 @@ func TestExplain(t *testing.T) {
 		t.Errorf("expected matched to be %v; got: %v", true, expl.Matched)
 	}
 }
+
+func TestExplainResponse(t *testing.T) {
+	body := `{
+		"_index": "twitter",
+		"_type": "tweet",
+		"_id": "1",
+		"matched": true,
+		"explanation": {
+			"value": 0.15342641,
+			"description": "fieldWeight in 0, product of:",
+			"details": [
+				{"value": 1.0, "description": "tf(freq=1.0), with freq of:", "details": [
+					{"value": 1.0, "description": "termFreq=1.0"}
+				]},
+				{"value": 0.30685282, "description": "idf(docFreq=1, maxDocs=1)"}
+			]
+		}
+	}`
+	var res ExplainResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.Matched {
+		t.Errorf("expected matched to be %v; got: %v", true, res.Matched)
+	}
+	if res.Explanation["description"] != "fieldWeight in 0, product of:" {
+		t.Errorf("expected description %q; got: %v", "fieldWeight in 0, product of:", res.Explanation["description"])
+	}
+	expl, err := res.SearchExplanation()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expl == nil {
+		t.Fatal("expected explanation; got nil")
+	}
+	if expl.Description != "fieldWeight in 0, product of:" {
+		t.Errorf("expected description %q; got: %q", "fieldWeight in 0, product of:", expl.Description)
+	}
+	if len(expl.Details) != 2 {
+		t.Fatalf("expected %d details; got: %d", 2, len(expl.Details))
+	}
+	tf := expl.Details[0]
+	if len(tf.Details) != 1 || tf.Details[0].Description != "termFreq=1.0" {
+		t.Errorf("expected nested detail %q; got: %v", "termFreq=1.0", tf.Details)
+	}
+}
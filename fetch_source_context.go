@@ -47,14 +47,26 @@ func (fsc *FetchSourceContext) TransformSource(transformSource bool) *FetchSourc
 	return fsc
 }
 
+// Source returns the JSON-serializable data for the "_source" field of
+// a request. It is false if the source should not be fetched at all, true
+// if it should be fetched completely, an array if only includes are given,
+// and an object with includes and excludes otherwise.
 func (fsc *FetchSourceContext) Source() interface{} {
 	if !fsc.fetchSource {
 		return false
 	}
-	return map[string]interface{}{
-		"includes": fsc.includes,
-		"excludes": fsc.excludes,
+	if len(fsc.includes) == 0 && len(fsc.excludes) == 0 {
+		return true
 	}
+	if len(fsc.excludes) == 0 {
+		return fsc.includes
+	}
+	source := make(map[string]interface{})
+	if len(fsc.includes) > 0 {
+		source["includes"] = fsc.includes
+	}
+	source["excludes"] = fsc.excludes
+	return source
 }
 
 // Query returns the parameters in a form suitable for a URL query string.
@@ -42,7 +42,7 @@ func TestFetchSourceContextFetchSource(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"excludes":[],"includes":[]}`
+	expected := `true`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -61,6 +61,32 @@ func TestFetchSourceContextFetchSourceWithIncludesAndExcludes(t *testing.T) {
 	}
 }
 
+func TestFetchSourceContextFetchSourceWithIncludes(t *testing.T) {
+	builder := NewFetchSourceContext(true).Include("a", "b")
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `["a","b"]`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFetchSourceContextFetchSourceWithExcludes(t *testing.T) {
+	builder := NewFetchSourceContext(true).Exclude("c")
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"excludes":["c"]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestFetchSourceContextQueryDefaults(t *testing.T) {
 	builder := NewFetchSourceContext(true)
 	values := builder.Query()
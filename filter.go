@@ -4,6 +4,9 @@
 
 package elastic
 
+// Filter is the interface all filters implement. Filters are used in
+// queries that accept a filter, e.g. the constant_score or the filtered
+// query. Source returns the JSON-serializable representation of the filter.
 type Filter interface {
 	Source() interface{}
 }
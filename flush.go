@@ -31,6 +31,7 @@ type FlushService struct {
 	expandWildcards   string
 }
 
+// NewFlushService creates a new FlushService.
 func NewFlushService(client *Client) *FlushService {
 	builder := &FlushService{
 		client: client,
@@ -98,8 +99,8 @@ func (s *FlushService) ExpandWildcards(expandWildcards string) *FlushService {
 	return s
 }
 
-// Do executes the service.
-func (s *FlushService) Do() (*FlushResult, error) {
+// buildURL builds the URL for the operation.
+func (s *FlushService) buildURL() (string, url.Values, error) {
 	// Build url
 	path := "/"
 
@@ -111,7 +112,7 @@ func (s *FlushService) Do() (*FlushResult, error) {
 				"index": index,
 			})
 			if err != nil {
-				return nil, err
+				return "", url.Values{}, err
 			}
 			indexPart = append(indexPart, index)
 		}
@@ -139,6 +140,15 @@ func (s *FlushService) Do() (*FlushResult, error) {
 	if s.expandWildcards != "" {
 		params.Set("expand_wildcards", s.expandWildcards)
 	}
+	return path, params, nil
+}
+
+// Do executes the service.
+func (s *FlushService) Do() (*FlushResult, error) {
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := s.client.PerformRequest("POST", path, params, nil)
@@ -156,12 +166,7 @@ func (s *FlushService) Do() (*FlushResult, error) {
 
 // -- Result of a flush request.
 
-type shardsInfo struct {
-	Total      int `json:"total"`
-	Successful int `json:"successful"`
-	Failed     int `json:"failed"`
-}
-
+// FlushResult is the outcome of FlushService.Do.
 type FlushResult struct {
-	Shards shardsInfo `json:"_shards"`
+	Shards ShardsInfo `json:"_shards"`
 }
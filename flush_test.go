@@ -19,4 +19,13 @@ func TestFlush(t *testing.T) {
 	if res == nil {
 		t.Errorf("expected res to be != nil; got: %v", res)
 	}
+
+	// Flush specific indices
+	res, err = client.Flush(testIndexName, testIndexName2).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Errorf("expected res to be != nil; got: %v", res)
+	}
 }
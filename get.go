@@ -13,6 +13,10 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// GetService retrieves a single document by its index, type, and id.
+// The type defaults to "_all".
+// See http://www.elastic.co/guide/en/elasticsearch/reference/current/docs-get.html
+// for details.
 type GetService struct {
 	client                        *Client
 	index                         string
@@ -29,6 +33,7 @@ type GetService struct {
 	ignoreErrorsOnGeneratedFields *bool
 }
 
+// NewGetService creates a new GetService.
 func NewGetService(client *Client) *GetService {
 	builder := &GetService{
 		client: client,
@@ -60,6 +65,8 @@ func (b *GetService) Id(id string) *GetService {
 	return b
 }
 
+// Parent sets the id of the parent document. It is used as routing
+// unless Routing is set explicitly.
 func (b *GetService) Parent(parent string) *GetService {
 	if b.routing == "" {
 		b.routing = parent
@@ -67,16 +74,20 @@ func (b *GetService) Parent(parent string) *GetService {
 	return b
 }
 
+// Routing sets the routing value of the document.
 func (b *GetService) Routing(routing string) *GetService {
 	b.routing = routing
 	return b
 }
 
+// Preference specifies the node or shard the operation should be
+// performed on, e.g. "_local". The default is random.
 func (b *GetService) Preference(preference string) *GetService {
 	b.preference = preference
 	return b
 }
 
+// Fields lists the stored fields to return.
 func (b *GetService) Fields(fields ...string) *GetService {
 	if b.fields == nil {
 		b.fields = make([]string, 0)
@@ -85,6 +96,7 @@ func (b *GetService) Fields(fields ...string) *GetService {
 	return b
 }
 
+// FetchSource indicates whether to return the _source of the document.
 func (s *GetService) FetchSource(fetchSource bool) *GetService {
 	if s.fsc == nil {
 		s.fsc = NewFetchSourceContext(fetchSource)
@@ -94,6 +106,7 @@ func (s *GetService) FetchSource(fetchSource bool) *GetService {
 	return s
 }
 
+// FetchSourceContext specifies which parts of the _source to return.
 func (s *GetService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *GetService {
 	s.fsc = fetchSourceContext
 	return s
@@ -104,6 +117,8 @@ func (b *GetService) Refresh(refresh bool) *GetService {
 	return b
 }
 
+// Realtime specifies whether to perform the operation in realtime
+// (the default) or in search mode, i.e. after the index has been refreshed.
 func (b *GetService) Realtime(realtime bool) *GetService {
 	b.realtime = &realtime
 	return b
@@ -142,12 +157,8 @@ func (s *GetService) Validate() error {
 	return nil
 }
 
-func (b *GetService) Do() (*GetResult, error) {
-	// Check pre-conditions
-	if err := b.Validate(); err != nil {
-		return nil, err
-	}
-
+// buildURL builds the URL for the operation.
+func (b *GetService) buildURL() (string, url.Values, error) {
 	// Build url
 	path, err := uritemplates.Expand("/{index}/{type}/{id}", map[string]string{
 		"index": b.index,
@@ -155,7 +166,7 @@ func (b *GetService) Do() (*GetResult, error) {
 		"id":    b.id,
 	})
 	if err != nil {
-		return nil, err
+		return "", url.Values{}, err
 	}
 
 	params := make(url.Values)
@@ -174,15 +185,9 @@ func (b *GetService) Do() (*GetResult, error) {
 	if b.refresh != nil {
 		params.Add("refresh", fmt.Sprintf("%v", *b.refresh))
 	}
-	if b.realtime != nil {
-		params.Add("realtime", fmt.Sprintf("%v", *b.realtime))
-	}
 	if b.ignoreErrorsOnGeneratedFields != nil {
 		params.Add("ignore_errors_on_generated_fields", fmt.Sprintf("%v", *b.ignoreErrorsOnGeneratedFields))
 	}
-	if len(b.fields) > 0 {
-		params.Add("_fields", strings.Join(b.fields, ","))
-	}
 	if b.version != nil {
 		params.Add("version", fmt.Sprintf("%d", *b.version))
 	}
@@ -194,6 +199,21 @@ func (b *GetService) Do() (*GetResult, error) {
 			params.Add(k, strings.Join(values, ","))
 		}
 	}
+	return path, params, nil
+}
+
+// Do executes the operation. If the document is not found, Do returns
+// no error but a GetResult with Found set to false.
+func (b *GetService) Do() (*GetResult, error) {
+	// Check pre-conditions
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
+
+	path, params, err := b.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := b.client.PerformRequest("GET", path, params, nil)
@@ -211,11 +231,14 @@ func (b *GetService) Do() (*GetResult, error) {
 
 // -- Result of a get request.
 
+// GetResult is the outcome of GetService.Do.
 type GetResult struct {
 	Index   string                 `json:"_index"`
 	Type    string                 `json:"_type"`
 	Id      string                 `json:"_id"`
 	Version int64                  `json:"_version,omitempty"`
+	Routing string                 `json:"_routing,omitempty"`
+	Parent  string                 `json:"_parent,omitempty"`
 	Source  *json.RawMessage       `json:"_source,omitempty"`
 	Found   bool                   `json:"found,omitempty"`
 	Fields  map[string]interface{} `json:"fields,omitempty"`
@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -141,9 +142,10 @@ func (s *GetMappingService) Validate() error {
 	return nil
 }
 
-// Do executes the operation. When successful, it returns a json.RawMessage.
-// If you specify an index, Elasticsearch returns HTTP status 404.
-// if you specify a type that does not exist, Elasticsearch returns
+// Do executes the operation. When successful, it returns the mappings
+// as a generic map, keyed by index name. If you specify an index that
+// does not exist, Do returns an *Error with status 404.
+// If you specify a type that does not exist, Elasticsearch returns
 // an empty map.
 func (s *GetMappingService) Do() (map[string]interface{}, error) {
 	// Check pre-conditions
@@ -162,6 +164,9 @@ func (s *GetMappingService) Do() (map[string]interface{}, error) {
 	if err != nil {
 		return nil, err
 	}
+	if res.StatusCode == http.StatusNotFound {
+		return nil, createErrorFromBody(res.StatusCode, res.Body)
+	}
 
 	// Return operation response
 	var ret map[string]interface{}
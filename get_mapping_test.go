@@ -5,6 +5,9 @@
 package elastic
 
 import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -48,3 +51,48 @@ func TestGetMappingURL(t *testing.T) {
 		}
 	}
 }
+
+func TestGetMappingOfMissingIndex(t *testing.T) {
+	client := setupTestClient(t)
+
+	_, err := client.GetMapping().Index("no-such-index").Do()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error of type *Error; got: %T", err)
+	}
+	if e.Status != 404 {
+		t.Errorf("expected status %d; got: %d", 404, e.Status)
+	}
+}
+
+func TestGetMappingOfMissingIndexWithUnexpectedBody(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `["no-such-index"]`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.GetMapping().Index("no-such-index").Do()
+	e, ok := err.(*Error)
+	if !ok {
+		t.Fatalf("expected error of type *Error; got: %T", err)
+	}
+	if e.Status != 404 {
+		t.Errorf("expected status %d; got: %d", 404, e.Status)
+	}
+	if got, want := string(e.Body), `["no-such-index"]`; got != want {
+		t.Errorf("expected body %q; got: %q", want, got)
+	}
+}
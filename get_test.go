@@ -166,3 +166,48 @@ func TestGetFailsWithMissingParams(t *testing.T) {
 		t.Fatal("expected Get to fail")
 	}
 }
+
+func TestGetWithRealtimeAndFieldsSendsEachParamOnce(t *testing.T) {
+	svc := NewGetService(nil).Index("twitter").Type("tweet").Id("1").
+		Realtime(false).
+		Fields("user", "message")
+	path, params, err := svc.buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/twitter/tweet/1" {
+		t.Errorf("expected path %q; got: %q", "/twitter/tweet/1", path)
+	}
+	if got := params["realtime"]; len(got) != 1 || got[0] != "false" {
+		t.Errorf("expected realtime = [false]; got: %v", got)
+	}
+	if got := params["fields"]; len(got) != 1 || got[0] != "user,message" {
+		t.Errorf("expected fields = [user,message]; got: %v", got)
+	}
+	if got, found := params["_fields"]; found {
+		t.Errorf("expected no _fields; got: %v", got)
+	}
+}
+
+func TestGetResultWithRouting(t *testing.T) {
+	body := `{"_index":"twitter","_type":"comment","_id":"1","_version":3,"_routing":"kimchy","_parent":"2","found":true,"_source":{"message":"Hello"}}`
+	var res GetResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+	if !res.Found {
+		t.Errorf("expected Found = %v; got: %v", true, res.Found)
+	}
+	if res.Version != 3 {
+		t.Errorf("expected Version = %d; got: %d", 3, res.Version)
+	}
+	if res.Routing != "kimchy" {
+		t.Errorf("expected Routing = %q; got: %q", "kimchy", res.Routing)
+	}
+	if res.Parent != "2" {
+		t.Errorf("expected Parent = %q; got: %q", "2", res.Parent)
+	}
+	if res.Source == nil || string(*res.Source) != `{"message":"Hello"}` {
+		t.Errorf("expected Source = %s; got: %v", `{"message":"Hello"}`, res.Source)
+	}
+}
@@ -120,16 +120,26 @@ func (hl *Highlight) Fragmenter(fragmenter string) *Highlight {
 	return hl
 }
 
-func (hl *Highlight) HighlighQuery(highlightQuery Query) *Highlight {
+func (hl *Highlight) HighlightQuery(highlightQuery Query) *Highlight {
 	hl.highlightQuery = highlightQuery
 	return hl
 }
 
+// HighlighQuery is deprecated. Use HighlightQuery instead.
+func (hl *Highlight) HighlighQuery(highlightQuery Query) *Highlight {
+	return hl.HighlightQuery(highlightQuery)
+}
+
 func (hl *Highlight) NoMatchSize(noMatchSize int) *Highlight {
 	hl.noMatchSize = &noMatchSize
 	return hl
 }
 
+func (hl *Highlight) PhraseLimit(phraseLimit int) *Highlight {
+	hl.phraseLimit = &phraseLimit
+	return hl
+}
+
 func (hl *Highlight) Options(options map[string]interface{}) *Highlight {
 	hl.options = options
 	return hl
@@ -183,7 +193,7 @@ func (hl *Highlight) Source() interface{} {
 		source["boundary_max_scan"] = *hl.boundaryMaxScan
 	}
 	if hl.boundaryChars != nil && len(hl.boundaryChars) > 0 {
-		source["boundary_chars"] = hl.boundaryChars
+		source["boundary_chars"] = string(hl.boundaryChars)
 	}
 	if hl.highlighterType != nil {
 		source["type"] = *hl.highlighterType
@@ -465,7 +475,7 @@ func (f *HighlighterField) Source() interface{} {
 		source["boundary_max_scan"] = f.boundaryMaxScan
 	}
 	if f.boundaryChars != nil && len(f.boundaryChars) > 0 {
-		source["boundary_chars"] = f.boundaryChars
+		source["boundary_chars"] = string(f.boundaryChars)
 	}
 	if f.highlighterType != nil {
 		source["type"] = *f.highlighterType
@@ -36,6 +36,40 @@ func TestHighlighterFieldWithOptions(t *testing.T) {
 	}
 }
 
+func TestHighlighterFieldWithTagsAndBoundaryChars(t *testing.T) {
+	field := NewHighlighterField("message").
+		PreTags("<b>").PostTags("</b>").
+		BoundaryChars('.', ',').
+		ForceSource(true)
+	data, err := json.Marshal(field.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"boundary_chars":".,","force_source":true,"post_tags":["\u003c/b\u003e"],"pre_tags":["\u003cb\u003e"]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHighlightWithOptions(t *testing.T) {
+	builder := NewHighlight().
+		Field("message").
+		FragmentSize(150).
+		NumOfFragments(3).
+		PhraseLimit(256).
+		HighlightQuery(NewTermQuery("message", "golang"))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"fields":{"message":{}},"fragment_size":150,"highlight_query":{"term":{"message":"golang"}},"number_of_fragments":3,"phrase_limit":256}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestHighlightWithStringField(t *testing.T) {
 	builder := NewHighlight().Field("grade")
 	data, err := json.Marshal(builder.Source())
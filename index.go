@@ -17,7 +17,7 @@ type IndexResult struct {
 	Index   string `json:"_index"`
 	Type    string `json:"_type"`
 	Id      string `json:"_id"`
-	Version int    `json:"_version"`
+	Version int64  `json:"_version"`
 	Created bool   `json:"created"`
 }
 
@@ -41,6 +41,7 @@ type IndexService struct {
 	pretty      bool
 }
 
+// NewIndexService creates a new IndexService.
 func NewIndexService(client *Client) *IndexService {
 	builder := &IndexService{
 		client: client,
@@ -48,26 +49,32 @@ func NewIndexService(client *Client) *IndexService {
 	return builder
 }
 
+// Index is the name of the index.
 func (b *IndexService) Index(name string) *IndexService {
 	b.index = name
 	return b
 }
 
+// Type is the type of the document.
 func (b *IndexService) Type(_type string) *IndexService {
 	b._type = _type
 	return b
 }
 
+// Id is the document id. If it is empty, Elasticsearch generates one
+// and the document is POSTed instead of PUT.
 func (b *IndexService) Id(id string) *IndexService {
 	b.id = id
 	return b
 }
 
+// Routing sets the routing value of the document.
 func (b *IndexService) Routing(routing string) *IndexService {
 	b.routing = routing
 	return b
 }
 
+// Parent sets the id of the parent document.
 func (b *IndexService) Parent(parent string) *IndexService {
 	b.parent = parent
 	return b
@@ -84,6 +91,8 @@ func (b *IndexService) Refresh(refresh bool) *IndexService {
 	return b
 }
 
+// Version sets the expected version of the document. Indexing fails
+// with a conflict if the document has a different version.
 func (b *IndexService) Version(version int64) *IndexService {
 	b.version = &version
 	return b
@@ -98,11 +107,13 @@ func (b *IndexService) VersionType(versionType string) *IndexService {
 	return b
 }
 
+// Timestamp sets the _timestamp of the document, e.g. "2009-11-15T14:12:12".
 func (b *IndexService) Timestamp(timestamp string) *IndexService {
 	b.timestamp = timestamp
 	return b
 }
 
+// TTL sets the time-to-live of the document, e.g. "1d".
 func (b *IndexService) TTL(ttl string) *IndexService {
 	b.ttl = ttl
 	return b
@@ -113,11 +124,13 @@ func (b *IndexService) Timeout(timeout string) *IndexService {
 	return b
 }
 
+// BodyString sets the document as a JSON-encoded string.
 func (b *IndexService) BodyString(body string) *IndexService {
 	b.bodyString = body
 	return b
 }
 
+// BodyJson sets the document. It is serialized with encoding/json.
 func (b *IndexService) BodyJson(json interface{}) *IndexService {
 	b.bodyJson = json
 	return b
@@ -128,7 +141,26 @@ func (b *IndexService) Pretty(pretty bool) *IndexService {
 	return b
 }
 
-func (b *IndexService) Do() (*IndexResult, error) {
+// Validate checks if the operation is valid.
+func (b *IndexService) Validate() error {
+	var invalid []string
+	if b.index == "" {
+		invalid = append(invalid, "Index")
+	}
+	if b._type == "" {
+		invalid = append(invalid, "Type")
+	}
+	if b.bodyString == "" && b.bodyJson == nil {
+		invalid = append(invalid, "BodyJson")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// buildURL builds the URL and HTTP method for the operation.
+func (b *IndexService) buildURL() (string, string, url.Values, error) {
 	// Build url
 	var path, method string
 	if b.id != "" {
@@ -147,7 +179,7 @@ func (b *IndexService) Do() (*IndexResult, error) {
 		"id":    b.id,
 	})
 	if err != nil {
-		return nil, err
+		return "", "", url.Values{}, err
 	}
 
 	// Parameters
@@ -182,17 +214,20 @@ func (b *IndexService) Do() (*IndexResult, error) {
 	if b.timeout != "" {
 		params.Set("timeout", b.timeout)
 	}
+	return method, path, params, nil
+}
+
+// Do executes the operation.
+func (b *IndexService) Do() (*IndexResult, error) {
+	// Check pre-conditions
+	if err := b.Validate(); err != nil {
+		return nil, err
+	}
 
-	/*
-		routing string
-		parent string
-		opType string
-		refresh *bool
-		version *int64
-		versionType string
-		timestamp string
-		ttl string
-	*/
+	method, path, params, err := b.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Body
 	var body interface{}
@@ -10,11 +10,13 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// IndexExistsService checks if an index exists.
 type IndexExistsService struct {
 	client *Client
 	index  string
 }
 
+// NewIndexExistsService creates a new IndexExistsService.
 func NewIndexExistsService(client *Client) *IndexExistsService {
 	builder := &IndexExistsService{
 		client: client,
@@ -22,12 +24,20 @@ func NewIndexExistsService(client *Client) *IndexExistsService {
 	return builder
 }
 
+// Index is the name of the index to check.
 func (b *IndexExistsService) Index(index string) *IndexExistsService {
 	b.index = index
 	return b
 }
 
+// Do executes the operation. It returns true if Elasticsearch responds
+// with 200 and false if it responds with 404. Other status codes
+// result in an error.
 func (b *IndexExistsService) Do() (bool, error) {
+	if b.index == "" {
+		return false, ErrMissingIndex
+	}
+
 	// Build url
 	path, err := uritemplates.Expand("/{index}", map[string]string{
 		"index": b.index,
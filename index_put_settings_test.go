@@ -0,0 +1,91 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"testing"
+)
+
+func TestIndicesPutSettingsBuildURL(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	tests := []struct {
+		Indices  []string
+		Expected string
+	}{
+		{
+			[]string{},
+			"/_settings",
+		},
+		{
+			[]string{"*"},
+			"/%2A/_settings",
+		},
+		{
+			[]string{"store-1", "store-2"},
+			"/store-1%2Cstore-2/_settings",
+		},
+	}
+
+	for _, test := range tests {
+		path, _, err := client.IndexPutSettings().Index(test.Indices...).buildURL()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != test.Expected {
+			t.Errorf("expected %q; got: %q", test.Expected, path)
+		}
+	}
+}
+
+func TestIndicesSettingsLifecycle(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	// Disable refresh during a bulk load
+	body := `{"index":{"refresh_interval":"-1"}}`
+	putres, err := client.IndexPutSettings(testIndexName).BodyString(body).Do()
+	if err != nil {
+		t.Fatalf("expected put settings to succeed; got: %v", err)
+	}
+	if putres == nil {
+		t.Fatalf("expected put settings response; got: %v", putres)
+	}
+	if !putres.Acknowledged {
+		t.Fatalf("expected put settings ack; got: %v", putres.Acknowledged)
+	}
+
+	// Check the setting
+	getres, err := client.IndexGetSettings(testIndexName).FlatSettings(true).Do()
+	if err != nil {
+		t.Fatalf("expected get settings to succeed; got: %v", err)
+	}
+	info, found := getres[testIndexName]
+	if !found || info == nil {
+		t.Fatalf("expected settings of index %q to be found; got: %v", testIndexName, found)
+	}
+	if got := info.Settings["index.refresh_interval"]; got != "-1" {
+		t.Errorf("expected refresh interval %q; got: %v", "-1", got)
+	}
+
+	// Restore the default
+	restore := map[string]interface{}{
+		"index": map[string]interface{}{
+			"refresh_interval": "1s",
+		},
+	}
+	putres, err = client.IndexPutSettings(testIndexName).BodyJson(restore).Do()
+	if err != nil {
+		t.Fatalf("expected put settings to succeed; got: %v", err)
+	}
+	if !putres.Acknowledged {
+		t.Fatalf("expected put settings ack; got: %v", putres.Acknowledged)
+	}
+}
+
+func TestIndicesPutSettingsValidate(t *testing.T) {
+	if err := NewIndicesPutSettingsService(nil).Validate(); err == nil {
+		t.Error("expected validation to fail without body")
+	}
+}
@@ -545,3 +545,39 @@ func TestIndexCreateExistsOpenCloseDelete(t *testing.T) {
 		t.Errorf("expected ack for deleting index; got %v", deleteIndex.Acknowledged)
 	}
 }
+
+func TestIndexResultWithLargeVersion(t *testing.T) {
+	body := `{"_index":"twitter","_type":"tweet","_id":"1","_version":4294967296,"created":false}`
+	var res IndexResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Version != 4294967296 {
+		t.Errorf("expected Version = %d; got %d", int64(4294967296), res.Version)
+	}
+}
+
+func TestIndexServiceValidate(t *testing.T) {
+	if err := NewIndexService(nil).Index("twitter").Type("tweet").BodyString(`{}`).Validate(); err != nil {
+		t.Errorf("expected no error; got: %v", err)
+	}
+	if err := NewIndexService(nil).Type("tweet").BodyString(`{}`).Validate(); err == nil {
+		t.Error("expected error without index")
+	}
+	if err := NewIndexService(nil).Index("twitter").BodyString(`{}`).Validate(); err == nil {
+		t.Error("expected error without type")
+	}
+	if err := NewIndexService(nil).Index("twitter").Type("tweet").Validate(); err == nil {
+		t.Error("expected error without body")
+	}
+}
+
+func TestIndexExistsWithoutIndexFails(t *testing.T) {
+	exists, err := NewIndexExistsService(nil).Do()
+	if err != ErrMissingIndex {
+		t.Fatalf("expected %v; got: %v", ErrMissingIndex, err)
+	}
+	if exists {
+		t.Errorf("expected exists = %v; got: %v", false, exists)
+	}
+}
@@ -0,0 +1,207 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/olivere/elastic/uritemplates"
+)
+
+// IndicesAnalyzeService performs the analysis process on a text and
+// returns the tokens breakdown of the text. It is useful to find out
+// why a query does (or does not) match a document.
+//
+// Use either Analyzer, Field or a combination of Tokenizer, Filters
+// and CharFilters to specify how the text is analyzed.
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/indices-analyze.html.
+type IndicesAnalyzeService struct {
+	client      *Client
+	pretty      bool
+	index       string
+	text        []string
+	analyzer    string
+	field       string
+	tokenizer   string
+	filters     []string
+	charFilters []string
+	preferLocal *bool
+}
+
+// NewIndicesAnalyzeService creates a new IndicesAnalyzeService.
+func NewIndicesAnalyzeService(client *Client) *IndicesAnalyzeService {
+	return &IndicesAnalyzeService{
+		client: client,
+	}
+}
+
+// Index is the name of the index to scope the operation, e.g. to use
+// an analyzer or field mapping defined in that index. It is optional.
+func (s *IndicesAnalyzeService) Index(index string) *IndicesAnalyzeService {
+	s.index = index
+	return s
+}
+
+// Text is the text to analyze. Passing more than one text analyzes
+// all of them as a multi-valued field.
+func (s *IndicesAnalyzeService) Text(text ...string) *IndicesAnalyzeService {
+	s.text = append(s.text, text...)
+	return s
+}
+
+// Analyzer is the name of the analyzer to use, e.g. "standard".
+func (s *IndicesAnalyzeService) Analyzer(analyzer string) *IndicesAnalyzeService {
+	s.analyzer = analyzer
+	return s
+}
+
+// Field uses the analyzer configured for this field
+// (instead of passing the analyzer name).
+func (s *IndicesAnalyzeService) Field(field string) *IndicesAnalyzeService {
+	s.field = field
+	return s
+}
+
+// Tokenizer is the name of the tokenizer to use for the analysis.
+func (s *IndicesAnalyzeService) Tokenizer(tokenizer string) *IndicesAnalyzeService {
+	s.tokenizer = tokenizer
+	return s
+}
+
+// Filters is a list of token filters to use for the analysis,
+// e.g. "lowercase".
+func (s *IndicesAnalyzeService) Filters(filters ...string) *IndicesAnalyzeService {
+	s.filters = append(s.filters, filters...)
+	return s
+}
+
+// CharFilters is a list of character filters to use for the analysis,
+// e.g. "html_strip".
+func (s *IndicesAnalyzeService) CharFilters(charFilters ...string) *IndicesAnalyzeService {
+	s.charFilters = append(s.charFilters, charFilters...)
+	return s
+}
+
+// PreferLocal, when true, specifies that a local shard should be used
+// if available. When false, a random shard is used (default: true).
+func (s *IndicesAnalyzeService) PreferLocal(preferLocal bool) *IndicesAnalyzeService {
+	s.preferLocal = &preferLocal
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *IndicesAnalyzeService) Pretty(pretty bool) *IndicesAnalyzeService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *IndicesAnalyzeService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	if s.index != "" {
+		path, err = uritemplates.Expand("/{index}/_analyze", map[string]string{
+			"index": s.index,
+		})
+	} else {
+		path = "/_analyze"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.preferLocal != nil {
+		params.Set("prefer_local", fmt.Sprintf("%v", *s.preferLocal))
+	}
+	return path, params, nil
+}
+
+// body returns the request body of the operation.
+func (s *IndicesAnalyzeService) body() interface{} {
+	body := make(map[string]interface{})
+	if len(s.text) == 1 {
+		body["text"] = s.text[0]
+	} else {
+		body["text"] = s.text
+	}
+	if s.analyzer != "" {
+		body["analyzer"] = s.analyzer
+	}
+	if s.field != "" {
+		body["field"] = s.field
+	}
+	if s.tokenizer != "" {
+		body["tokenizer"] = s.tokenizer
+	}
+	if len(s.filters) > 0 {
+		body["filters"] = s.filters
+	}
+	if len(s.charFilters) > 0 {
+		body["char_filters"] = s.charFilters
+	}
+	return body
+}
+
+// Validate checks if the operation is valid.
+func (s *IndicesAnalyzeService) Validate() error {
+	var invalid []string
+	if len(s.text) == 0 {
+		invalid = append(invalid, "Text")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *IndicesAnalyzeService) Do() (*IndicesAnalyzeResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest("POST", path, params, s.body())
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(IndicesAnalyzeResponse)
+	if err := json.Unmarshal(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// IndicesAnalyzeResponse is the response of IndicesAnalyzeService.Do.
+type IndicesAnalyzeResponse struct {
+	Tokens []*IndicesAnalyzeResponseToken `json:"tokens"`
+}
+
+// IndicesAnalyzeResponseToken is a single token of the analyzed text.
+type IndicesAnalyzeResponseToken struct {
+	Token       string `json:"token"`
+	StartOffset int    `json:"start_offset"`
+	EndOffset   int    `json:"end_offset"`
+	Type        string `json:"type"`
+	Position    int    `json:"position"`
+}
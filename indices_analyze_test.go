@@ -0,0 +1,134 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestIndicesAnalyzeBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *IndicesAnalyzeService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewIndicesAnalyzeService(nil),
+			"/_analyze",
+			"",
+		},
+		{
+			NewIndicesAnalyzeService(nil).Index("tweets"),
+			"/tweets/_analyze",
+			"",
+		},
+		{
+			NewIndicesAnalyzeService(nil).Index("tweets").PreferLocal(false),
+			"/tweets/_analyze",
+			"prefer_local=false",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestIndicesAnalyzeBody(t *testing.T) {
+	tests := []struct {
+		Service  *IndicesAnalyzeService
+		Expected string
+	}{
+		{
+			NewIndicesAnalyzeService(nil).Text("Quick Brown Fox").Analyzer("standard"),
+			`{"analyzer":"standard","text":"Quick Brown Fox"}`,
+		},
+		{
+			NewIndicesAnalyzeService(nil).Text("Quick", "Brown Fox").Field("message"),
+			`{"field":"message","text":["Quick","Brown Fox"]}`,
+		},
+		{
+			NewIndicesAnalyzeService(nil).Text("Quick Fox").Tokenizer("keyword").Filters("lowercase").CharFilters("html_strip"),
+			`{"char_filters":["html_strip"],"filters":["lowercase"],"text":"Quick Fox","tokenizer":"keyword"}`,
+		},
+	}
+
+	for _, test := range tests {
+		data, err := json.Marshal(test.Service.body())
+		if err != nil {
+			t.Fatalf("marshaling to JSON failed: %v", err)
+		}
+		got := string(data)
+		if got != test.Expected {
+			t.Errorf("expected\n%s\n,got:\n%s", test.Expected, got)
+		}
+	}
+}
+
+func TestIndicesAnalyzeValidate(t *testing.T) {
+	if err := NewIndicesAnalyzeService(nil).Analyzer("standard").Validate(); err == nil {
+		t.Errorf("expected Validate to fail without Text")
+	}
+}
+
+func TestIndicesAnalyzeResponse(t *testing.T) {
+	body := `{
+		"tokens": [
+			{"token":"quick","start_offset":0,"end_offset":5,"type":"<ALPHANUM>","position":1},
+			{"token":"brown","start_offset":6,"end_offset":11,"type":"<ALPHANUM>","position":2}
+		]
+	}`
+	var res IndicesAnalyzeResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Tokens) != 2 {
+		t.Fatalf("expected %d tokens; got: %d", 2, len(res.Tokens))
+	}
+	tok := res.Tokens[1]
+	if tok.Token != "brown" {
+		t.Errorf("expected Token %q; got: %q", "brown", tok.Token)
+	}
+	if tok.StartOffset != 6 {
+		t.Errorf("expected StartOffset %d; got: %d", 6, tok.StartOffset)
+	}
+	if tok.EndOffset != 11 {
+		t.Errorf("expected EndOffset %d; got: %d", 11, tok.EndOffset)
+	}
+	if tok.Type != "<ALPHANUM>" {
+		t.Errorf("expected Type %q; got: %q", "<ALPHANUM>", tok.Type)
+	}
+	if tok.Position != 2 {
+		t.Errorf("expected Position %d; got: %d", 2, tok.Position)
+	}
+}
+
+func TestIndicesAnalyze(t *testing.T) {
+	client := setupTestClient(t)
+
+	res, err := client.Analyze("").Text("Quick Brown Fox").Analyzer("standard").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatalf("expected response; got: %v", res)
+	}
+	if len(res.Tokens) != 3 {
+		t.Fatalf("expected %d tokens; got: %d", 3, len(res.Tokens))
+	}
+	if res.Tokens[0].Token != "quick" {
+		t.Errorf("expected first token %q; got: %q", "quick", res.Tokens[0].Token)
+	}
+}
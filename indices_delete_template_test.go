@@ -0,0 +1,41 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"testing"
+)
+
+func TestIndexDeleteTemplateURL(t *testing.T) {
+	tests := []struct {
+		Service        *IndicesDeleteTemplateService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewIndicesDeleteTemplateService(nil).Name("template_1"),
+			"/_template/template_1",
+			"",
+		},
+		{
+			NewIndicesDeleteTemplateService(nil).Name("template_1").MasterTimeout("10s"),
+			"/_template/template_1",
+			"master_timeout=10s",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
-// IndicesPutTemplateService creates or updates index mappings.
+// IndicesPutTemplateService creates or updates index templates.
+// Index templates define settings and mappings that are automatically
+// applied when new indices matching the template pattern are created.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/indices-templates.html.
 type IndicesPutTemplateService struct {
 	client        *Client
@@ -78,13 +80,14 @@ func (s *IndicesPutTemplateService) Pretty(pretty bool) *IndicesPutTemplateServi
 	return s
 }
 
-// BodyJson is documented as: The template definition.
+// BodyJson is the template definition, e.g. with "template", "settings"
+// and "mappings".
 func (s *IndicesPutTemplateService) BodyJson(body interface{}) *IndicesPutTemplateService {
 	s.bodyJson = body
 	return s
 }
 
-// BodyString is documented as: The template definition.
+// BodyString is the template definition, serialized as a string.
 func (s *IndicesPutTemplateService) BodyString(body string) *IndicesPutTemplateService {
 	s.bodyString = body
 	return s
@@ -0,0 +1,58 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"testing"
+)
+
+func TestIndexPutTemplateURL(t *testing.T) {
+	tests := []struct {
+		Service        *IndicesPutTemplateService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewIndicesPutTemplateService(nil).Name("template_1"),
+			"/_template/template_1",
+			"",
+		},
+		{
+			NewIndicesPutTemplateService(nil).Name("template_1").Order(2).Create(true),
+			"/_template/template_1",
+			"create=true&order=2",
+		},
+		{
+			NewIndicesPutTemplateService(nil).Name("template_1").Timeout("5s").MasterTimeout("10s"),
+			"/_template/template_1",
+			"master_timeout=10s&timeout=5s",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestIndexPutTemplateValidate(t *testing.T) {
+	if err := NewIndicesPutTemplateService(nil).BodyString(`{}`).Validate(); err == nil {
+		t.Errorf("expected Validate to fail without Name")
+	}
+	if err := NewIndicesPutTemplateService(nil).Name("template_1").Validate(); err == nil {
+		t.Errorf("expected Validate to fail without body")
+	}
+	if err := NewIndicesPutTemplateService(nil).Name("template_1").BodyJson(map[string]interface{}{"template": "te*"}).Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import "log"
+
+// Logger specifies the interface for all log operations of a Client,
+// e.g. for passing a structured logger to SetErrorLog, SetInfoLog,
+// or SetTraceLog. A *log.Logger satisfies this interface.
+type Logger interface {
+	Printf(format string, v ...interface{})
+}
+
+// nilIfNoLogger returns nil if logger is nil or a nil *log.Logger,
+// so that the client doesn't call Printf on a nil *log.Logger.
+func nilIfNoLogger(logger Logger) Logger {
+	if l, ok := logger.(*log.Logger); ok && l == nil {
+		return nil
+	}
+	return logger
+}
@@ -10,6 +10,9 @@ import (
 	"net/url"
 )
 
+// MultiGetService retrieves multiple documents in one request.
+// See http://www.elastic.co/guide/en/elasticsearch/reference/current/docs-multi-get.html
+// for details.
 type MultiGetService struct {
 	client     *Client
 	preference string
@@ -18,6 +21,7 @@ type MultiGetService struct {
 	items      []*MultiGetItem
 }
 
+// NewMultiGetService creates a new MultiGetService.
 func NewMultiGetService(client *Client) *MultiGetService {
 	builder := &MultiGetService{
 		client: client,
@@ -41,11 +45,13 @@ func (b *MultiGetService) Realtime(realtime bool) *MultiGetService {
 	return b
 }
 
+// Add adds documents to retrieve.
 func (b *MultiGetService) Add(items ...*MultiGetItem) *MultiGetService {
 	b.items = append(b.items, items...)
 	return b
 }
 
+// Source returns the body of the request, i.e. { "docs" : [ ... ] }.
 func (b *MultiGetService) Source() interface{} {
 	source := make(map[string]interface{})
 	items := make([]interface{}, len(b.items))
@@ -56,6 +62,8 @@ func (b *MultiGetService) Source() interface{} {
 	return source
 }
 
+// Do executes the operation. Documents that are not found are returned
+// with GetResult.Found set to false, in the order they were added.
 func (b *MultiGetService) Do() (*MultiGetResult, error) {
 	// Build url
 	path := "/_mget"
@@ -75,7 +83,7 @@ func (b *MultiGetService) Do() (*MultiGetResult, error) {
 	body := b.Source()
 
 	// Get response
-	res, err := b.client.PerformRequest("GET", path, params, body)
+	res, err := b.client.PerformRequest("POST", path, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -102,6 +110,7 @@ type MultiGetItem struct {
 	fsc         *FetchSourceContext
 }
 
+// NewMultiGetItem creates a new MultiGetItem.
 func NewMultiGetItem() *MultiGetItem {
 	return &MultiGetItem{}
 }
@@ -150,6 +159,7 @@ func (item *MultiGetItem) VersionType(versionType string) *MultiGetItem {
 	return item
 }
 
+// FetchSource specifies whether and which parts of the _source to return.
 func (item *MultiGetItem) FetchSource(fetchSourceContext *FetchSourceContext) *MultiGetItem {
 	item.fsc = fetchSourceContext
 	return item
@@ -178,7 +188,7 @@ func (item *MultiGetItem) Source() interface{} {
 		source["_routing"] = item.routing
 	}
 	if item.version != nil {
-		source["version"] = fmt.Sprintf("%d", *item.version)
+		source["version"] = *item.version
 	}
 	if item.versionType != "" {
 		source["version_type"] = item.versionType
@@ -189,6 +199,7 @@ func (item *MultiGetItem) Source() interface{} {
 
 // -- Result of a Multi Get request.
 
+// MultiGetResult is the outcome of MultiGetService.Do.
 type MultiGetResult struct {
 	Docs []*GetResult `json:"docs,omitempty"`
 }
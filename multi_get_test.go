@@ -93,3 +93,22 @@ func TestMultiGet(t *testing.T) {
 		t.Errorf("expected Message of second tweet to be %q; got %q", tweet3.Message, doc.Message)
 	}
 }
+
+func TestMultiGetSource(t *testing.T) {
+	svc := NewMultiGetService(nil).Add(
+		NewMultiGetItem().Index("twitter").Type("tweet").Id("1"),
+		NewMultiGetItem().Index("twitter").Type("tweet").Id("2").
+			FetchSource(NewFetchSourceContext(true).Include("user")).
+			Routing("kimchy").
+			Version(3),
+	)
+	data, err := json.Marshal(svc.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"docs":[{"_id":"1","_index":"twitter","_type":"tweet"},{"_id":"2","_index":"twitter","_routing":"kimchy","_source":["user"],"_type":"tweet","version":3}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -9,9 +9,13 @@ import (
 	"fmt"
 	"net/url"
 	"strings"
+
+	"github.com/olivere/elastic/uritemplates"
 )
 
-// MultiSearch executes one or more searches in one roundtrip.
+// MultiSearchService executes one or more searches in one roundtrip.
+// Each search is a SearchRequest with its own indices, types, and
+// search source.
 // See http://www.elasticsearch.org/guide/reference/api/multi-search/
 type MultiSearchService struct {
 	client     *Client
@@ -22,6 +26,7 @@ type MultiSearchService struct {
 	preference string
 }
 
+// NewMultiSearchService creates a new MultiSearchService.
 func NewMultiSearchService(client *Client) *MultiSearchService {
 	builder := &MultiSearchService{
 		client:   client,
@@ -31,59 +36,94 @@ func NewMultiSearchService(client *Client) *MultiSearchService {
 	return builder
 }
 
+// Add adds one or more search requests. The responses of
+// MultiSearchResult are in the same order.
 func (s *MultiSearchService) Add(requests ...*SearchRequest) *MultiSearchService {
 	s.requests = append(s.requests, requests...)
 	return s
 }
 
+// Index sets a default index for all search requests
+// that don't specify indices themselves.
 func (s *MultiSearchService) Index(index string) *MultiSearchService {
 	s.indices = append(s.indices, index)
 	return s
 }
 
+// Indices sets default indices for all search requests
+// that don't specify indices themselves.
 func (s *MultiSearchService) Indices(indices ...string) *MultiSearchService {
 	s.indices = append(s.indices, indices...)
 	return s
 }
 
+// Pretty indicates that the JSON response be indented and human readable.
 func (s *MultiSearchService) Pretty(pretty bool) *MultiSearchService {
 	s.pretty = pretty
 	return s
 }
 
-func (s *MultiSearchService) Do() (*MultiSearchResult, error) {
-	// Build url
-	path := "/_msearch"
+// buildURL builds the URL for the operation.
+func (s *MultiSearchService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	if len(s.indices) > 0 {
+		path, err = uritemplates.Expand("/{index}/_msearch", map[string]string{
+			"index": strings.Join(s.indices, ","),
+		})
+	} else {
+		path = "/_msearch"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
 
 	// Parameters
 	params := make(url.Values)
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
+	return path, params, nil
+}
 
-	// Set body
+// body returns the body of the operation: A header line and a body
+// line for each search request, each followed by a newline.
+func (s *MultiSearchService) body() (string, error) {
 	lines := make([]string, 0)
 	for _, sr := range s.requests {
-		// Set default indices if not specified in the request
-		if !sr.HasIndices() && len(s.indices) > 0 {
-			sr = sr.Indices(s.indices...)
-		}
-
 		header, err := json.Marshal(sr.header())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		body, err := json.Marshal(sr.body())
 		if err != nil {
-			return nil, err
+			return "", err
 		}
 		lines = append(lines, string(header))
 		lines = append(lines, string(body))
 	}
-	body := strings.Join(lines, "\n") + "\n" // Don't forget trailing \n
+	return strings.Join(lines, "\n") + "\n", nil // Don't forget trailing \n
+}
+
+// Do executes the operation. If a single search fails, the whole
+// operation doesn't fail. Instead, the Error of the corresponding
+// response in MultiSearchResult is set.
+func (s *MultiSearchService) Do() (*MultiSearchResult, error) {
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Set body
+	body, err := s.body()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
-	res, err := s.client.PerformRequest("GET", path, params, body)
+	res, err := s.client.PerformRequest("POST", path, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -96,6 +136,9 @@ func (s *MultiSearchService) Do() (*MultiSearchResult, error) {
 	return ret, nil
 }
 
+// MultiSearchResult is the result of MultiSearchService.Do.
+// Responses are in the order of the search requests.
+// Failed searches have their Error (and Status in ES 2.x) set.
 type MultiSearchResult struct {
 	Responses []*SearchResult `json:"responses,omitempty"`
 }
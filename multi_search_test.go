@@ -6,7 +6,9 @@ package elastic
 
 import (
 	"encoding/json"
-	_ "net/http"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -195,3 +197,61 @@ func TestMultiSearchWithOneRequest(t *testing.T) {
 		}
 	}
 }
+
+func TestMultiSearchSendsDefaultIndicesInURL(t *testing.T) {
+	var method, path string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		method, path = r.Method, r.URL.Path
+		fmt.Fprint(w, `{"responses":[{"hits":{"total":0,"hits":[]}}]}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.MultiSearch().
+		Indices("twitter", "gplus").
+		Add(NewSearchRequest().Source(NewSearchSource().Query(NewMatchAllQuery()))).
+		Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if method != "POST" {
+		t.Errorf("expected method %q; got: %q", "POST", method)
+	}
+	if path != "/twitter,gplus/_msearch" {
+		t.Errorf("expected path %q; got: %q", "/twitter,gplus/_msearch", path)
+	}
+}
+
+func TestMultiSearchBody(t *testing.T) {
+	sreq1 := NewSearchRequest().Indices("twitter", "gplus").
+		Source(NewSearchSource().Query(NewMatchAllQuery()).Size(10))
+	sreq2 := NewSearchRequest().Index("twitter").Type("tweet").SearchTypeCount().
+		Source(NewSearchSource().Query(NewTermQuery("tags", "golang")))
+	sreq3 := NewSearchRequest()
+
+	got, err := NewMultiSearchService(nil).Index("default").Add(sreq1, sreq2, sreq3).body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := `{"indices":["twitter","gplus"]}
+{"query":{"match_all":{}},"size":10}
+{"index":"twitter","search_type":"count","type":"tweet"}
+{"query":{"term":{"tags":"golang"}}}
+{}
+{}
+`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+	if sreq3.HasIndices() {
+		t.Errorf("expected default indices not to modify the search request; got: %v", sreq3.indices)
+	}
+}
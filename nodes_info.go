@@ -171,7 +171,7 @@ type NodesInfoNode struct {
 	Process *NodesInfoNodeProcess `json:"process"`
 
 	// JVM information, e.g. VM version.
-	JVM *NodesInfoNodeProcess `json:"jvm"`
+	JVM *NodesInfoNodeJVM `json:"jvm"`
 
 	// ThreadPool information.
 	ThreadPool *NodesInfoNodeThreadPool `json:"thread_pool"`
@@ -291,15 +291,15 @@ type NodesInfoNodeNetwork struct {
 }
 
 type NodesInfoNodeTransport struct {
-	BoundAddress   string `json:"bound_address"`   // e.g. inet[/127.0.0.1:9300]
-	PublishAddress string `json:"publish_address"` // e.g. inet[/127.0.0.1:9300]
+	BoundAddress   interface{} `json:"bound_address"`   // e.g. inet[/127.0.0.1:9300] in 1.x, ["127.0.0.1:9300"] in 2.x
+	PublishAddress string      `json:"publish_address"` // e.g. inet[/127.0.0.1:9300] in 1.x, 127.0.0.1:9300 in 2.x
 }
 
 type NodesInfoNodeHTTP struct {
-	BoundAddress            string `json:"bound_address"`      // e.g. inet[/127.0.0.1:9300]
-	PublishAddress          string `json:"publish_address"`    // e.g. inet[/127.0.0.1:9300]
-	MaxContentLength        string `json:"max_content_length"` // e.g. "100mb"
-	MaxContentLengthInBytes int64  `json:"max_content_length_in_bytes"`
+	BoundAddress            interface{} `json:"bound_address"`      // e.g. inet[/127.0.0.1:9300] in 1.x, ["127.0.0.1:9200"] in 2.x
+	PublishAddress          string      `json:"publish_address"`    // e.g. inet[/127.0.0.1:9300] in 1.x, 127.0.0.1:9200 in 2.x
+	MaxContentLength        string      `json:"max_content_length"` // e.g. "100mb"
+	MaxContentLengthInBytes int64       `json:"max_content_length_in_bytes"`
 }
 
 type NodesInfoNodePlugin struct {
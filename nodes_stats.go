@@ -0,0 +1,543 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/olivere/elastic/uritemplates"
+)
+
+// NodesStatsService returns statistics of one or more or all of the
+// cluster nodes, e.g. JVM heap usage, garbage collection, disk usage,
+// and index statistics.
+// It is documented at http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/cluster-nodes-stats.html.
+type NodesStatsService struct {
+	client           *Client
+	pretty           bool
+	nodeId           []string
+	metric           []string
+	indexMetric      []string
+	completionFields []string
+	fielddataFields  []string
+	fields           []string
+	groups           *bool
+	human            *bool
+	level            string
+	types            []string
+}
+
+// NewNodesStatsService creates a new NodesStatsService.
+func NewNodesStatsService(client *Client) *NodesStatsService {
+	return &NodesStatsService{
+		client:           client,
+		nodeId:           make([]string, 0),
+		metric:           make([]string, 0),
+		indexMetric:      make([]string, 0),
+		completionFields: make([]string, 0),
+		fielddataFields:  make([]string, 0),
+		fields:           make([]string, 0),
+		types:            make([]string, 0),
+	}
+}
+
+// NodeId is a list of node IDs or names to limit the returned information.
+// Use "_local" to return information from the node you're connecting to,
+// leave empty to get information from all nodes.
+func (s *NodesStatsService) NodeId(nodeId ...string) *NodesStatsService {
+	s.nodeId = append(s.nodeId, nodeId...)
+	return s
+}
+
+// Metric limits the information returned to the specified metrics.
+// Valid metrics are: _all, breaker, fs, http, indices, jvm, network,
+// os, process, thread_pool, and transport. Leave empty to return all.
+func (s *NodesStatsService) Metric(metric ...string) *NodesStatsService {
+	s.metric = append(s.metric, metric...)
+	return s
+}
+
+// IndexMetric limits the information returned for the indices metric
+// to the specified index metrics, e.g. docs, store, indexing, search,
+// fielddata, or segments. It is only used if the indices (or all)
+// metric is specified.
+func (s *NodesStatsService) IndexMetric(indexMetric ...string) *NodesStatsService {
+	s.indexMetric = append(s.indexMetric, indexMetric...)
+	return s
+}
+
+// CompletionFields is a list of fields for the completion index metric
+// (supports wildcards).
+func (s *NodesStatsService) CompletionFields(completionFields ...string) *NodesStatsService {
+	s.completionFields = append(s.completionFields, completionFields...)
+	return s
+}
+
+// FielddataFields is a list of fields for the fielddata index metric
+// (supports wildcards).
+func (s *NodesStatsService) FielddataFields(fielddataFields ...string) *NodesStatsService {
+	s.fielddataFields = append(s.fielddataFields, fielddataFields...)
+	return s
+}
+
+// Fields is a list of fields for the fielddata and completion index
+// metrics (supports wildcards).
+func (s *NodesStatsService) Fields(fields ...string) *NodesStatsService {
+	s.fields = append(s.fields, fields...)
+	return s
+}
+
+// Groups indicates whether to return search statistics by groups.
+func (s *NodesStatsService) Groups(groups bool) *NodesStatsService {
+	s.groups = &groups
+	return s
+}
+
+// Human indicates whether to return time and byte values in human-readable format.
+func (s *NodesStatsService) Human(human bool) *NodesStatsService {
+	s.human = &human
+	return s
+}
+
+// Level specifies whether to return indices stats aggregated at
+// node, index, or shard level. Valid values are: node (default),
+// indices, or shards.
+func (s *NodesStatsService) Level(level string) *NodesStatsService {
+	s.level = level
+	return s
+}
+
+// Types is a list of document types for the indexing index metric.
+func (s *NodesStatsService) Types(types ...string) *NodesStatsService {
+	s.types = append(s.types, types...)
+	return s
+}
+
+// Pretty indicates whether to indent the returned JSON.
+func (s *NodesStatsService) Pretty(pretty bool) *NodesStatsService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *NodesStatsService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	if len(s.nodeId) > 0 && len(s.metric) > 0 && len(s.indexMetric) > 0 {
+		path, err = uritemplates.Expand("/_nodes/{node_id}/stats/{metric}/{index_metric}", map[string]string{
+			"node_id":      strings.Join(s.nodeId, ","),
+			"metric":       strings.Join(s.metric, ","),
+			"index_metric": strings.Join(s.indexMetric, ","),
+		})
+	} else if len(s.nodeId) > 0 && len(s.metric) > 0 {
+		path, err = uritemplates.Expand("/_nodes/{node_id}/stats/{metric}", map[string]string{
+			"node_id": strings.Join(s.nodeId, ","),
+			"metric":  strings.Join(s.metric, ","),
+		})
+	} else if len(s.metric) > 0 && len(s.indexMetric) > 0 {
+		path, err = uritemplates.Expand("/_nodes/stats/{metric}/{index_metric}", map[string]string{
+			"metric":       strings.Join(s.metric, ","),
+			"index_metric": strings.Join(s.indexMetric, ","),
+		})
+	} else if len(s.metric) > 0 {
+		path, err = uritemplates.Expand("/_nodes/stats/{metric}", map[string]string{
+			"metric": strings.Join(s.metric, ","),
+		})
+	} else if len(s.nodeId) > 0 {
+		path, err = uritemplates.Expand("/_nodes/{node_id}/stats", map[string]string{
+			"node_id": strings.Join(s.nodeId, ","),
+		})
+	} else {
+		path = "/_nodes/stats"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if len(s.completionFields) > 0 {
+		params.Set("completion_fields", strings.Join(s.completionFields, ","))
+	}
+	if len(s.fielddataFields) > 0 {
+		params.Set("fielddata_fields", strings.Join(s.fielddataFields, ","))
+	}
+	if len(s.fields) > 0 {
+		params.Set("fields", strings.Join(s.fields, ","))
+	}
+	if s.groups != nil {
+		params.Set("groups", fmt.Sprintf("%v", *s.groups))
+	}
+	if s.human != nil {
+		params.Set("human", fmt.Sprintf("%v", *s.human))
+	}
+	if s.level != "" {
+		params.Set("level", s.level)
+	}
+	if len(s.types) > 0 {
+		params.Set("types", strings.Join(s.types, ","))
+	}
+	return path, params, nil
+}
+
+// Validate checks if the operation is valid.
+func (s *NodesStatsService) Validate() error {
+	return nil
+}
+
+// Do executes the operation.
+func (s *NodesStatsService) Do() (*NodesStatsResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	res, err := s.client.PerformRequest("GET", path, params, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(NodesStatsResponse)
+	if err := json.Unmarshal(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// NodesStatsResponse is the response of NodesStatsService.Do.
+type NodesStatsResponse struct {
+	ClusterName string                     `json:"cluster_name"`
+	Nodes       map[string]*NodesStatsNode `json:"nodes"`
+}
+
+// NodesStatsNode are the statistics of a single node. Sections that
+// were not requested via NodesStatsService.Metric are nil.
+type NodesStatsNode struct {
+	// Timestamp when these stats were collected, in milliseconds since the epoch.
+	Timestamp int64 `json:"timestamp"`
+	// Name of the node, e.g. "Mister Fear"
+	Name string `json:"name"`
+	// TransportAddress, e.g. "inet[/127.0.0.1:9300]"
+	TransportAddress string `json:"transport_address"`
+	// Host is the host name, e.g. "macbookair"
+	Host string `json:"host"`
+	// IP is either a string like "192.168.1.2" or an array like
+	// ["inet[/192.168.1.2:9300]", "NONE"], depending on the version.
+	IP interface{} `json:"ip"`
+	// Attributes of the node.
+	Attributes map[string]interface{} `json:"attributes"`
+
+	// Indices statistics, e.g. docs, store, indexing, and search.
+	Indices *NodesStatsIndex `json:"indices"`
+
+	// OS statistics, e.g. load, CPU, and memory.
+	OS *NodesStatsNodeOS `json:"os"`
+
+	// Process statistics, e.g. open file descriptors and CPU.
+	Process *NodesStatsNodeProcess `json:"process"`
+
+	// JVM statistics, e.g. heap usage and garbage collection.
+	JVM *NodesStatsNodeJVM `json:"jvm"`
+
+	// ThreadPool statistics, keyed by the name of the thread pool, e.g. "search".
+	ThreadPool map[string]*NodesStatsNodeThreadPool `json:"thread_pool"`
+
+	// FS statistics, e.g. total and free disk space.
+	FS *NodesStatsNodeFS `json:"fs"`
+
+	// Network statistics.
+	Network map[string]interface{} `json:"network"`
+
+	// Transport statistics.
+	Transport *NodesStatsNodeTransport `json:"transport"`
+
+	// HTTP statistics.
+	HTTP *NodesStatsNodeHTTP `json:"http"`
+
+	// Breaker statistics, keyed by the name of the circuit breaker, e.g. "fielddata".
+	Breaker map[string]*NodesStatsBreaker `json:"breakers"`
+}
+
+type NodesStatsIndex struct {
+	Docs        *NodesStatsDocsStats        `json:"docs"`
+	Store       *NodesStatsStoreStats       `json:"store"`
+	Indexing    *NodesStatsIndexingStats    `json:"indexing"`
+	Get         *NodesStatsGetStats         `json:"get"`
+	Search      *NodesStatsSearchStats      `json:"search"`
+	Merges      *NodesStatsMergeStats       `json:"merges"`
+	Refresh     *NodesStatsRefreshStats     `json:"refresh"`
+	Flush       *NodesStatsFlushStats       `json:"flush"`
+	Warmer      *NodesStatsWarmerStats      `json:"warmer"`
+	FilterCache *NodesStatsFilterCacheStats `json:"filter_cache"`
+	IdCache     *NodesStatsIdCacheStats     `json:"id_cache"`
+	Fielddata   *NodesStatsFielddataStats   `json:"fielddata"`
+	Segments    *NodesStatsSegmentsStats    `json:"segments"`
+	Translog    *NodesStatsTranslogStats    `json:"translog"`
+
+	// Indices are the statistics per index, only returned with Level "indices".
+	Indices map[string]interface{} `json:"indices"`
+}
+
+type NodesStatsDocsStats struct {
+	Count   int64 `json:"count"`
+	Deleted int64 `json:"deleted"`
+}
+
+type NodesStatsStoreStats struct {
+	Size                 string `json:"size"` // e.g. "5.3gb"
+	SizeInBytes          int64  `json:"size_in_bytes"`
+	ThrottleTime         string `json:"throttle_time"` // e.g. "0s"
+	ThrottleTimeInMillis int64  `json:"throttle_time_in_millis"`
+}
+
+type NodesStatsIndexingStats struct {
+	IndexTotal           int64 `json:"index_total"`
+	IndexTimeInMillis    int64 `json:"index_time_in_millis"`
+	IndexCurrent         int64 `json:"index_current"`
+	DeleteTotal          int64 `json:"delete_total"`
+	DeleteTimeInMillis   int64 `json:"delete_time_in_millis"`
+	DeleteCurrent        int64 `json:"delete_current"`
+	NoopUpdateTotal      int64 `json:"noop_update_total"`
+	IsThrottled          bool  `json:"is_throttled"`
+	ThrottleTimeInMillis int64 `json:"throttle_time_in_millis"`
+}
+
+type NodesStatsGetStats struct {
+	Total               int64 `json:"total"`
+	TimeInMillis        int64 `json:"time_in_millis"`
+	ExistsTotal         int64 `json:"exists_total"`
+	ExistsTimeInMillis  int64 `json:"exists_time_in_millis"`
+	MissingTotal        int64 `json:"missing_total"`
+	MissingTimeInMillis int64 `json:"missing_time_in_millis"`
+	Current             int64 `json:"current"`
+}
+
+type NodesStatsSearchStats struct {
+	OpenContexts      int64 `json:"open_contexts"`
+	QueryTotal        int64 `json:"query_total"`
+	QueryTimeInMillis int64 `json:"query_time_in_millis"`
+	QueryCurrent      int64 `json:"query_current"`
+	FetchTotal        int64 `json:"fetch_total"`
+	FetchTimeInMillis int64 `json:"fetch_time_in_millis"`
+	FetchCurrent      int64 `json:"fetch_current"`
+}
+
+type NodesStatsMergeStats struct {
+	Current            int64 `json:"current"`
+	CurrentDocs        int64 `json:"current_docs"`
+	CurrentSizeInBytes int64 `json:"current_size_in_bytes"`
+	Total              int64 `json:"total"`
+	TotalTimeInMillis  int64 `json:"total_time_in_millis"`
+	TotalDocs          int64 `json:"total_docs"`
+	TotalSizeInBytes   int64 `json:"total_size_in_bytes"`
+}
+
+type NodesStatsRefreshStats struct {
+	Total             int64 `json:"total"`
+	TotalTimeInMillis int64 `json:"total_time_in_millis"`
+}
+
+type NodesStatsFlushStats struct {
+	Total             int64 `json:"total"`
+	TotalTimeInMillis int64 `json:"total_time_in_millis"`
+}
+
+type NodesStatsWarmerStats struct {
+	Current           int64 `json:"current"`
+	Total             int64 `json:"total"`
+	TotalTimeInMillis int64 `json:"total_time_in_millis"`
+}
+
+type NodesStatsFilterCacheStats struct {
+	MemorySizeInBytes int64 `json:"memory_size_in_bytes"`
+	Evictions         int64 `json:"evictions"`
+}
+
+type NodesStatsIdCacheStats struct {
+	MemorySizeInBytes int64 `json:"memory_size_in_bytes"`
+}
+
+type NodesStatsFielddataStats struct {
+	MemorySizeInBytes int64 `json:"memory_size_in_bytes"`
+	Evictions         int64 `json:"evictions"`
+	Fields            map[string]struct {
+		MemorySizeInBytes int64 `json:"memory_size_in_bytes"`
+	} `json:"fields"`
+}
+
+type NodesStatsSegmentsStats struct {
+	Count         int64 `json:"count"`
+	MemoryInBytes int64 `json:"memory_in_bytes"`
+}
+
+type NodesStatsTranslogStats struct {
+	Operations  int64 `json:"operations"`
+	SizeInBytes int64 `json:"size_in_bytes"`
+}
+
+type NodesStatsNodeOS struct {
+	Timestamp      int64 `json:"timestamp"`
+	UptimeInMillis int64 `json:"uptime_in_millis"`
+	// LoadAverage is an array of the 1, 5, and 15 minute averages,
+	// e.g. [1.41, 1.52, 1.61]. It is not available on all platforms.
+	LoadAverage []float64 `json:"load_average"`
+
+	// CPU usage in percent.
+	CPU *struct {
+		Sys    int `json:"sys"`
+		User   int `json:"user"`
+		Idle   int `json:"idle"`
+		Usage  int `json:"usage"`
+		Stolen int `json:"stolen"`
+	} `json:"cpu"`
+
+	// Mem usage.
+	Mem *struct {
+		FreeInBytes       int64 `json:"free_in_bytes"`
+		UsedInBytes       int64 `json:"used_in_bytes"`
+		FreePercent       int   `json:"free_percent"`
+		UsedPercent       int   `json:"used_percent"`
+		ActualFreeInBytes int64 `json:"actual_free_in_bytes"`
+		ActualUsedInBytes int64 `json:"actual_used_in_bytes"`
+	} `json:"mem"`
+
+	// Swap usage.
+	Swap *struct {
+		UsedInBytes int64 `json:"used_in_bytes"`
+		FreeInBytes int64 `json:"free_in_bytes"`
+	} `json:"swap"`
+}
+
+type NodesStatsNodeProcess struct {
+	Timestamp           int64 `json:"timestamp"`
+	OpenFileDescriptors int64 `json:"open_file_descriptors"`
+
+	// CPU usage of the process.
+	CPU *struct {
+		Percent       int   `json:"percent"`
+		SysInMillis   int64 `json:"sys_in_millis"`
+		UserInMillis  int64 `json:"user_in_millis"`
+		TotalInMillis int64 `json:"total_in_millis"`
+	} `json:"cpu"`
+
+	// Mem usage of the process.
+	Mem *struct {
+		ResidentInBytes     int64 `json:"resident_in_bytes"`
+		ShareInBytes        int64 `json:"share_in_bytes"`
+		TotalVirtualInBytes int64 `json:"total_virtual_in_bytes"`
+	} `json:"mem"`
+}
+
+type NodesStatsNodeJVM struct {
+	Timestamp      int64 `json:"timestamp"`
+	UptimeInMillis int64 `json:"uptime_in_millis"`
+
+	// Mem usage of the JVM, e.g. heap usage.
+	Mem *struct {
+		HeapUsedInBytes         int64 `json:"heap_used_in_bytes"`
+		HeapUsedPercent         int   `json:"heap_used_percent"`
+		HeapCommittedInBytes    int64 `json:"heap_committed_in_bytes"`
+		HeapMaxInBytes          int64 `json:"heap_max_in_bytes"`
+		NonHeapUsedInBytes      int64 `json:"non_heap_used_in_bytes"`
+		NonHeapCommittedInBytes int64 `json:"non_heap_committed_in_bytes"`
+		Pools                   map[string]struct {
+			UsedInBytes     int64 `json:"used_in_bytes"`
+			MaxInBytes      int64 `json:"max_in_bytes"`
+			PeakUsedInBytes int64 `json:"peak_used_in_bytes"`
+			PeakMaxInBytes  int64 `json:"peak_max_in_bytes"`
+		} `json:"pools"` // e.g. "young", "survivor", and "old"
+	} `json:"mem"`
+
+	// Threads of the JVM.
+	Threads *struct {
+		Count     int `json:"count"`
+		PeakCount int `json:"peak_count"`
+	} `json:"threads"`
+
+	// GC statistics of the JVM.
+	GC *struct {
+		Collectors map[string]struct {
+			CollectionCount        int64 `json:"collection_count"`
+			CollectionTimeInMillis int64 `json:"collection_time_in_millis"`
+		} `json:"collectors"` // e.g. "young" and "old"
+	} `json:"gc"`
+
+	// BufferPools of the JVM, e.g. "direct" and "mapped".
+	BufferPools map[string]struct {
+		Count                int64 `json:"count"`
+		UsedInBytes          int64 `json:"used_in_bytes"`
+		TotalCapacityInBytes int64 `json:"total_capacity_in_bytes"`
+	} `json:"buffer_pools"`
+}
+
+type NodesStatsNodeThreadPool struct {
+	Threads   int   `json:"threads"`
+	Queue     int   `json:"queue"`
+	Active    int   `json:"active"`
+	Rejected  int64 `json:"rejected"`
+	Largest   int   `json:"largest"`
+	Completed int64 `json:"completed"`
+}
+
+type NodesStatsNodeFS struct {
+	Timestamp int64 `json:"timestamp"`
+
+	// Total disk usage over all data paths.
+	Total *NodesStatsNodeFSEntry `json:"total"`
+
+	// Data contains the disk usage per data path.
+	Data []*NodesStatsNodeFSEntry `json:"data"`
+}
+
+type NodesStatsNodeFSEntry struct {
+	Path                 string `json:"path"`  // e.g. "/var/lib/elasticsearch/nodes/0"
+	Mount                string `json:"mount"` // e.g. "/"
+	Dev                  string `json:"dev"`   // e.g. "/dev/sda1"
+	Type                 string `json:"type"`  // e.g. "ext4"
+	TotalInBytes         int64  `json:"total_in_bytes"`
+	FreeInBytes          int64  `json:"free_in_bytes"`
+	AvailableInBytes     int64  `json:"available_in_bytes"`
+	DiskReads            int64  `json:"disk_reads"`
+	DiskWrites           int64  `json:"disk_writes"`
+	DiskReadSizeInBytes  int64  `json:"disk_read_size_in_bytes"`
+	DiskWriteSizeInBytes int64  `json:"disk_write_size_in_bytes"`
+}
+
+type NodesStatsNodeTransport struct {
+	ServerOpen    int   `json:"server_open"`
+	RxCount       int64 `json:"rx_count"`
+	RxSizeInBytes int64 `json:"rx_size_in_bytes"`
+	TxCount       int64 `json:"tx_count"`
+	TxSizeInBytes int64 `json:"tx_size_in_bytes"`
+}
+
+type NodesStatsNodeHTTP struct {
+	CurrentOpen int   `json:"current_open"`
+	TotalOpened int64 `json:"total_opened"`
+}
+
+type NodesStatsBreaker struct {
+	LimitSizeInBytes     int64   `json:"limit_size_in_bytes"`
+	LimitSize            string  `json:"limit_size"` // e.g. "2.3gb"
+	EstimatedSizeInBytes int64   `json:"estimated_size_in_bytes"`
+	EstimatedSize        string  `json:"estimated_size"` // e.g. "0b"
+	Overhead             float64 `json:"overhead"`
+	Tripped              int64   `json:"tripped"`
+}
@@ -0,0 +1,164 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"net/url"
+	"testing"
+)
+
+func TestNodesStats(t *testing.T) {
+	client, err := NewClient()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	stats, err := client.NodesStats().Metric("jvm", "fs").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats == nil {
+		t.Fatal("expected nodes stats")
+	}
+
+	if stats.ClusterName == "" {
+		t.Errorf("expected cluster name; got: %q", stats.ClusterName)
+	}
+	if len(stats.Nodes) == 0 {
+		t.Errorf("expected some nodes; got: %d", len(stats.Nodes))
+	}
+	for id, node := range stats.Nodes {
+		if id == "" {
+			t.Errorf("expected node id; got: %q", id)
+		}
+		if node == nil {
+			t.Fatalf("expected node stats; got: %v", node)
+		}
+		if node.JVM == nil {
+			t.Errorf("expected JVM stats; got: %v", node.JVM)
+		}
+		if node.Indices != nil {
+			t.Errorf("expected no indices stats; got: %v", node.Indices)
+		}
+	}
+}
+
+func TestNodesStatsBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *NodesStatsService
+		ExpectedPath   string
+		ExpectedParams url.Values
+	}{
+		{
+			Service:      NewNodesStatsService(nil),
+			ExpectedPath: "/_nodes/stats",
+		},
+		{
+			Service:      NewNodesStatsService(nil).NodeId("node1", "node2"),
+			ExpectedPath: "/_nodes/node1%2Cnode2/stats",
+		},
+		{
+			Service:      NewNodesStatsService(nil).Metric("jvm", "os"),
+			ExpectedPath: "/_nodes/stats/jvm%2Cos",
+		},
+		{
+			Service:      NewNodesStatsService(nil).NodeId("_local").Metric("fs"),
+			ExpectedPath: "/_nodes/_local/stats/fs",
+		},
+		{
+			Service:      NewNodesStatsService(nil).Metric("indices").IndexMetric("docs", "store"),
+			ExpectedPath: "/_nodes/stats/indices/docs%2Cstore",
+		},
+		{
+			Service:        NewNodesStatsService(nil).NodeId("node1").Metric("indices").IndexMetric("search").Level("indices"),
+			ExpectedPath:   "/_nodes/node1/stats/indices/search",
+			ExpectedParams: url.Values{"level": []string{"indices"}},
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path = %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams.Encode() {
+			t.Errorf("expected URL params = %v; got: %v", test.ExpectedParams, gotParams)
+		}
+	}
+}
+
+func TestNodesStatsResponse(t *testing.T) {
+	body := `{
+		"cluster_name":"elasticsearch",
+		"nodes":{
+			"DWtrjqPvRnqrOqRrk2sbpQ":{
+				"timestamp":1432061988155,
+				"name":"Mister Fear",
+				"transport_address":"inet[/127.0.0.1:9300]",
+				"host":"macbookair",
+				"ip":["inet[/127.0.0.1:9300]","NONE"],
+				"indices":{
+					"docs":{"count":1024,"deleted":3},
+					"store":{"size_in_bytes":2048,"throttle_time_in_millis":0},
+					"search":{"open_contexts":0,"query_total":17,"query_time_in_millis":42,"query_current":0,"fetch_total":5,"fetch_time_in_millis":3,"fetch_current":0}
+				},
+				"jvm":{
+					"timestamp":1432061988155,
+					"uptime_in_millis":123456,
+					"mem":{"heap_used_in_bytes":104857600,"heap_used_percent":10,"heap_committed_in_bytes":259522560,"heap_max_in_bytes":1037959168},
+					"gc":{"collectors":{"young":{"collection_count":12,"collection_time_in_millis":150},"old":{"collection_count":1,"collection_time_in_millis":20}}}
+				},
+				"fs":{
+					"timestamp":1432061988155,
+					"total":{"total_in_bytes":499046809600,"free_in_bytes":94961999872,"available_in_bytes":94699855872},
+					"data":[{"path":"/var/lib/elasticsearch/nodes/0","mount":"/","type":"hfs","total_in_bytes":499046809600,"free_in_bytes":94961999872,"available_in_bytes":94699855872}]
+				}
+			}
+		}
+	}`
+
+	var res NodesStatsResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	node, found := res.Nodes["DWtrjqPvRnqrOqRrk2sbpQ"]
+	if !found {
+		t.Fatalf("expected stats of node %q; got: %v", "DWtrjqPvRnqrOqRrk2sbpQ", res.Nodes)
+	}
+	if node.Name != "Mister Fear" {
+		t.Errorf("expected Name = %q; got: %q", "Mister Fear", node.Name)
+	}
+	if node.Indices == nil || node.Indices.Docs == nil || node.Indices.Search == nil {
+		t.Fatalf("expected indices stats; got: %v", node.Indices)
+	}
+	if node.Indices.Docs.Count != 1024 {
+		t.Errorf("expected Docs.Count = %d; got: %d", 1024, node.Indices.Docs.Count)
+	}
+	if node.Indices.Search.QueryTotal != 17 {
+		t.Errorf("expected Search.QueryTotal = %d; got: %d", 17, node.Indices.Search.QueryTotal)
+	}
+	if node.JVM == nil || node.JVM.Mem == nil || node.JVM.GC == nil {
+		t.Fatalf("expected JVM stats; got: %v", node.JVM)
+	}
+	if node.JVM.Mem.HeapUsedInBytes != 104857600 {
+		t.Errorf("expected JVM.Mem.HeapUsedInBytes = %d; got: %d", 104857600, node.JVM.Mem.HeapUsedInBytes)
+	}
+	if young, found := node.JVM.GC.Collectors["young"]; !found || young.CollectionCount != 12 {
+		t.Errorf("expected young GC collection count = %d; got: %v", 12, node.JVM.GC.Collectors)
+	}
+	if node.FS == nil || node.FS.Total == nil {
+		t.Fatalf("expected FS stats; got: %v", node.FS)
+	}
+	if node.FS.Total.FreeInBytes != 94961999872 {
+		t.Errorf("expected FS.Total.FreeInBytes = %d; got: %d", int64(94961999872), node.FS.Total.FreeInBytes)
+	}
+	if len(node.FS.Data) != 1 {
+		t.Errorf("expected %d data paths; got: %d", 1, len(node.FS.Data))
+	}
+}
@@ -13,6 +13,12 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// OptimizeService merges the segments of one or more indices in order
+// to reduce their number, e.g. for read-heavy indices that don't change
+// any more. Elasticsearch 2.1 renamed the API to force merge, but still
+// accepts _optimize.
+// See http://www.elastic.co/guide/en/elasticsearch/reference/1.x/indices-optimize.html
+// for details.
 type OptimizeService struct {
 	client             *Client
 	indices            []string
@@ -24,6 +30,7 @@ type OptimizeService struct {
 	pretty             bool
 }
 
+// NewOptimizeService creates a new OptimizeService.
 func NewOptimizeService(client *Client) *OptimizeService {
 	builder := &OptimizeService{
 		client:  client,
@@ -32,31 +39,40 @@ func NewOptimizeService(client *Client) *OptimizeService {
 	return builder
 }
 
+// Index adds an index to optimize.
 func (s *OptimizeService) Index(index string) *OptimizeService {
 	s.indices = append(s.indices, index)
 	return s
 }
 
+// Indices adds indices to optimize. All indices are optimized
+// if none are given.
 func (s *OptimizeService) Indices(indices ...string) *OptimizeService {
 	s.indices = append(s.indices, indices...)
 	return s
 }
 
+// MaxNumSegments is the number of segments to merge to. Use 1 to fully
+// optimize an index.
 func (s *OptimizeService) MaxNumSegments(maxNumSegments int) *OptimizeService {
 	s.maxNumSegments = &maxNumSegments
 	return s
 }
 
+// OnlyExpungeDeletes only merges segments that contain deletes.
 func (s *OptimizeService) OnlyExpungeDeletes(onlyExpungeDeletes bool) *OptimizeService {
 	s.onlyExpungeDeletes = &onlyExpungeDeletes
 	return s
 }
 
+// Flush specifies whether to flush after optimizing (default: true).
 func (s *OptimizeService) Flush(flush bool) *OptimizeService {
 	s.flush = &flush
 	return s
 }
 
+// WaitForMerge specifies whether the request blocks until the merge
+// is completed (default: true).
 func (s *OptimizeService) WaitForMerge(waitForMerge bool) *OptimizeService {
 	s.waitForMerge = &waitForMerge
 	return s
@@ -72,26 +88,26 @@ func (s *OptimizeService) Pretty(pretty bool) *OptimizeService {
 	return s
 }
 
-func (s *OptimizeService) Do() (*OptimizeResult, error) {
+// buildURL builds the URL for the operation.
+func (s *OptimizeService) buildURL() (string, url.Values, error) {
 	// Build url
 	path := "/"
 
 	// Indices part
-	indexPart := make([]string, 0)
-	for _, index := range s.indices {
-		index, err := uritemplates.Expand("{index}", map[string]string{
-			"index": index,
-		})
-		if err != nil {
-			return nil, err
+	if len(s.indices) > 0 {
+		indexPart := make([]string, 0)
+		for _, index := range s.indices {
+			index, err := uritemplates.Expand("{index}", map[string]string{
+				"index": index,
+			})
+			if err != nil {
+				return "", url.Values{}, err
+			}
+			indexPart = append(indexPart, index)
 		}
-		indexPart = append(indexPart, index)
-	}
-	if len(indexPart) > 0 {
-		path += strings.Join(indexPart, ",")
+		path += strings.Join(indexPart, ",") + "/"
 	}
-
-	path += "/_optimize"
+	path += "_optimize"
 
 	// Parameters
 	params := make(url.Values)
@@ -113,6 +129,15 @@ func (s *OptimizeService) Do() (*OptimizeResult, error) {
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
+	return path, params, nil
+}
+
+// Do executes the operation.
+func (s *OptimizeService) Do() (*OptimizeResult, error) {
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := s.client.PerformRequest("POST", path, params, nil)
@@ -130,6 +155,7 @@ func (s *OptimizeService) Do() (*OptimizeResult, error) {
 
 // -- Result of an optimize request.
 
+// OptimizeResult is the outcome of OptimizeService.Do.
 type OptimizeResult struct {
-	Shards shardsInfo `json:"_shards,omitempty"`
+	Shards ShardsInfo `json:"_shards,omitempty"`
 }
@@ -45,3 +45,24 @@ func TestOptimize(t *testing.T) {
 		t.Fatal("expected result; got nil")
 	}
 }
+
+func TestOptimizeAllIndices(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	// Optimize all indices
+	res, err := client.Optimize().Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatal("expected result; got nil")
+	}
+
+	path, _, err := client.Optimize().buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/_optimize" {
+		t.Errorf("expected path %q; got: %q", "/_optimize", path)
+	}
+}
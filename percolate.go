@@ -13,7 +13,21 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
-// PercolateService is documented at http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/search-percolate.html.
+// PercolateService checks which registered queries match a document.
+//
+// Queries are registered with the IndexService in the ".percolator" type
+// of an index, e.g.:
+//
+//	q := NewSearchSource().Query(NewMatchQuery("message", "Golang"))
+//	client.Index().Index("tweets").Type(".percolator").Id("golang").BodyJson(q.Source()).Do()
+//
+// Then a document can be percolated against the registered queries,
+// either by passing it with Doc or by specifying an existing document
+// with Id:
+//
+//	res, err := client.Percolate().Index("tweets").Type("tweet").Doc(tweet).Do()
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/1.4/search-percolate.html.
 type PercolateService struct {
 	client              *Client
 	pretty              bool
@@ -177,12 +191,22 @@ func (s *PercolateService) BodyString(body string) *PercolateService {
 
 // buildURL builds the URL for the operation.
 func (s *PercolateService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
 	// Build URL
-	path, err := uritemplates.Expand("/{index}/{type}/_percolate", map[string]string{
-		"index": s.index,
-		"type":  s.typ,
-		"id":    s.id,
-	})
+	if s.id != "" {
+		path, err = uritemplates.Expand("/{index}/{type}/{id}/_percolate", map[string]string{
+			"index": s.index,
+			"type":  s.typ,
+			"id":    s.id,
+		})
+	} else {
+		path, err = uritemplates.Expand("/{index}/{type}/_percolate", map[string]string{
+			"index": s.index,
+			"type":  s.typ,
+		})
+	}
 	if err != nil {
 		return "", url.Values{}, err
 	}
@@ -288,6 +312,7 @@ func (s *PercolateService) Do() (*PercolateResponse, error) {
 type PercolateResponse struct {
 	TookInMillis int64             `json:"took"`  // search time in milliseconds
 	Total        int64             `json:"total"` // total matches
+	Shards       *ShardsInfo       `json:"_shards,omitempty"`
 	Matches      []*PercolateMatch `json:"matches,omitempty"`
 	Facets       SearchFacets      `json:"facets,omitempty"`       // results from facets
 	Aggregations Aggregations      `json:"aggregations,omitempty"` // results from aggregations
@@ -4,7 +4,10 @@
 
 package elastic
 
-import "testing"
+import (
+	"encoding/json"
+	"testing"
+)
 
 func TestPercolate(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t) //, SetTraceLog(log.New(os.Stdout, "", 0)))
@@ -57,3 +60,30 @@ func TestPercolate(t *testing.T) {
 		t.Errorf("expected to return query %q; got: %q", "1", matches[0].Id)
 	}
 }
+
+func TestPercolateExistingDocument(t *testing.T) {
+	path, _, err := NewPercolateService(nil).Index("tweets").Type("tweet").Id("1").buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/tweets/tweet/1/_percolate" {
+		t.Errorf("expected path %q; got: %q", "/tweets/tweet/1/_percolate", path)
+	}
+
+	body := `{
+		"took": 19,
+		"_shards": {"total": 5, "successful": 4, "failed": 1},
+		"total": 1,
+		"matches": [{"_index": "tweets", "_id": "golang"}]
+	}`
+	var res PercolateResponse
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Shards == nil {
+		t.Fatal("expected shards != nil; got nil")
+	}
+	if res.Shards.Total != 5 || res.Shards.Successful != 4 || res.Shards.Failed != 1 {
+		t.Errorf("expected 4 of 5 successful shards and 1 failed; got: %+v", res.Shards)
+	}
+}
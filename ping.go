@@ -98,12 +98,14 @@ func (s *PingService) Do() (*PingResult, int, error) {
 	if err != nil {
 		return nil, 0, err
 	}
+	s.client.setRequestHeaders(req)
 
 	res, err := s.client.c.Do((*http.Request)(req))
 	if err != nil {
 		return nil, 0, err
 	}
 	defer res.Body.Close()
+	gunzipResponse(res)
 
 	var ret *PingResult
 	if !s.httpHeadOnly {
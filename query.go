@@ -12,3 +12,15 @@ package elastic
 type Query interface {
 	Source() interface{}
 }
+
+// invalidQuery is returned from the Source method of a query that is
+// missing mandatory parts. It reports the error when it is serialized,
+// so the request fails before it is sent to Elasticsearch.
+type invalidQuery struct {
+	err error
+}
+
+// MarshalJSON always returns the error of the query.
+func (q invalidQuery) MarshalJSON() ([]byte, error) {
+	return nil, q.err
+}
@@ -13,6 +13,10 @@ import (
 	"github.com/olivere/elastic/uritemplates"
 )
 
+// RefreshService refreshes one or more indices, making all operations
+// performed since the last refresh available for search.
+// See http://www.elastic.co/guide/en/elasticsearch/reference/current/indices-refresh.html
+// for details.
 type RefreshService struct {
 	client  *Client
 	indices []string
@@ -20,6 +24,7 @@ type RefreshService struct {
 	pretty  bool
 }
 
+// NewRefreshService creates a new RefreshService.
 func NewRefreshService(client *Client) *RefreshService {
 	builder := &RefreshService{
 		client:  client,
@@ -28,11 +33,14 @@ func NewRefreshService(client *Client) *RefreshService {
 	return builder
 }
 
+// Index adds an index to refresh.
 func (s *RefreshService) Index(index string) *RefreshService {
 	s.indices = append(s.indices, index)
 	return s
 }
 
+// Indices adds indices to refresh. All indices are refreshed
+// if none are given.
 func (s *RefreshService) Indices(indices ...string) *RefreshService {
 	s.indices = append(s.indices, indices...)
 	return s
@@ -48,26 +56,26 @@ func (s *RefreshService) Pretty(pretty bool) *RefreshService {
 	return s
 }
 
-func (s *RefreshService) Do() (*RefreshResult, error) {
+// buildURL builds the URL for the operation.
+func (s *RefreshService) buildURL() (string, url.Values, error) {
 	// Build url
 	path := "/"
 
 	// Indices part
-	indexPart := make([]string, 0)
-	for _, index := range s.indices {
-		index, err := uritemplates.Expand("{index}", map[string]string{
-			"index": index,
-		})
-		if err != nil {
-			return nil, err
+	if len(s.indices) > 0 {
+		indexPart := make([]string, 0)
+		for _, index := range s.indices {
+			index, err := uritemplates.Expand("{index}", map[string]string{
+				"index": index,
+			})
+			if err != nil {
+				return "", url.Values{}, err
+			}
+			indexPart = append(indexPart, index)
 		}
-		indexPart = append(indexPart, index)
-	}
-	if len(indexPart) > 0 {
-		path += strings.Join(indexPart, ",")
+		path += strings.Join(indexPart, ",") + "/"
 	}
-
-	path += "/_refresh"
+	path += "_refresh"
 
 	// Parameters
 	params := make(url.Values)
@@ -77,6 +85,15 @@ func (s *RefreshService) Do() (*RefreshResult, error) {
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
+	return path, params, nil
+}
+
+// Do executes the operation.
+func (s *RefreshService) Do() (*RefreshResult, error) {
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
 
 	// Get response
 	res, err := s.client.PerformRequest("POST", path, params, nil)
@@ -94,6 +111,7 @@ func (s *RefreshService) Do() (*RefreshResult, error) {
 
 // -- Result of a refresh request.
 
+// RefreshResult is the outcome of RefreshService.Do.
 type RefreshResult struct {
-	Shards shardsInfo `json:"_shards,omitempty"`
+	Shards ShardsInfo `json:"_shards,omitempty"`
 }
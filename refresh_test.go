@@ -45,3 +45,24 @@ func TestRefresh(t *testing.T) {
 		t.Fatal("expected result; got nil")
 	}
 }
+
+func TestRefreshAllIndices(t *testing.T) {
+	client := setupTestClientAndCreateIndex(t)
+
+	// Refresh all indices
+	res, err := client.Refresh().Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res == nil {
+		t.Fatal("expected result; got nil")
+	}
+
+	path, _, err := client.Refresh().buildURL()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if path != "/_refresh" {
+		t.Errorf("expected path %q; got: %q", "/_refresh", path)
+	}
+}
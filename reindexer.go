@@ -7,6 +7,7 @@ package elastic
 import (
 	"encoding/json"
 	"errors"
+	"time"
 )
 
 // Reindexer simplifies the process of reindexing an index. You typically
@@ -40,6 +41,7 @@ type Reindexer struct {
 	reindexerFunc              ReindexerFunc
 	progress                   ReindexerProgressFunc
 	statsOnly                  bool
+	bulkRetries                int
 }
 
 // A ReindexerFunc receives each hit from the sourceIndex.
@@ -67,6 +69,23 @@ func CopyToTargetIndex(targetIndex string) ReindexerFunc {
 	}
 }
 
+// ReindexerTransform returns a ReindexerFunc that adds the request
+// returned by transform for every hit, e.g. to rename fields or to
+// compute the id of the document in the target index. If transform
+// returns a nil request, the hit is skipped.
+func ReindexerTransform(transform func(hit *SearchHit) (*BulkIndexRequest, error)) ReindexerFunc {
+	return func(hit *SearchHit, bulkService *BulkService) error {
+		req, err := transform(hit)
+		if err != nil {
+			return err
+		}
+		if req != nil {
+			bulkService.Add(req)
+		}
+		return nil
+	}
+}
+
 // ReindexerProgressFunc is a callback that can be used with Reindexer
 // to report progress while reindexing data.
 type ReindexerProgressFunc func(current, total int64)
@@ -134,6 +153,18 @@ func (ix *Reindexer) Progress(f ReindexerProgressFunc) *Reindexer {
 	return ix
 }
 
+// BulkRetries specifies how many times a bulk request that failed
+// transiently, i.e. with a connection error, a server error (5xx), or
+// 429 Too Many Requests, is retried before Do gives up. It waits
+// exponentially longer between retries, like the default Retrier of
+// the client. Retries are disabled by default.
+// Notice that documents that failed individually within a successful
+// bulk request are not retried, but reported in ReindexerResponse.
+func (ix *Reindexer) BulkRetries(retries int) *Reindexer {
+	ix.bulkRetries = retries
+	return ix
+}
+
 // StatsOnly indicates whether the Do method should return details e.g. about
 // the documents that failed while indexing. It is true by default, i.e. only
 // the number of documents that succeeded/failed are returned. Set to false
@@ -180,6 +211,9 @@ func (ix *Reindexer) Do() (*ReindexerResponse, error) {
 		scanner = scanner.Query(ix.query)
 	}
 	cursor, err := scanner.Do()
+	if err != nil {
+		return nil, err
+	}
 
 	bulk := ix.targetClient.Bulk()
 
@@ -190,7 +224,7 @@ func (ix *Reindexer) Do() (*ReindexerResponse, error) {
 	// Main loop iterates through the source index and bulk indexes into target.
 	for {
 		docs, err := cursor.Next()
-		if err == EOS {
+		if IsEOS(err) {
 			break
 		}
 		if err != nil {
@@ -242,10 +276,25 @@ func (ix *Reindexer) count() (int64, error) {
 }
 
 // commit commits a bulk, updates the stats, and returns a fresh bulk service.
+// A failed bulk request is retried as specified by BulkRetries.
 func (ix *Reindexer) commit(bulk *BulkService, ret *ReindexerResponse) (*BulkService, error) {
-	bres, err := bulk.Do()
-	if err != nil {
-		return nil, err
+	var bres *BulkResponse
+	var err error
+	retrier := NewBackoffRetrier(ix.bulkRetries+1, DefaultRetryInitialWait, DefaultRetryMaxWait)
+	for retry := 1; ; retry++ {
+		bres, err = bulk.Do()
+		if err == nil {
+			break
+		}
+		if !isTransientError(err) {
+			return nil, err
+		}
+		wait, ok := retrier.Retry(retry, nil, nil, err)
+		if !ok {
+			return nil, err
+		}
+		ix.targetClient.errorf("elastic: retrying bulk request while reindexing after error: %v", err)
+		time.Sleep(wait)
 	}
 	ret.Success += int64(len(bres.Succeeded()))
 	failed := bres.Failed()
@@ -2,6 +2,9 @@ package elastic
 
 import (
 	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 )
 
@@ -286,3 +289,102 @@ func TestReindexerPreservingTTL(t *testing.T) {
 	}
 
 }
+
+func TestReindexerTransform(t *testing.T) {
+	f := ReindexerTransform(func(hit *SearchHit) (*BulkIndexRequest, error) {
+		if hit.Id == "2" {
+			return nil, nil // skip
+		}
+		return NewBulkIndexRequest().Index("target").Type(hit.Type).Id("new-" + hit.Id).Doc(hit.Source), nil
+	})
+	source := json.RawMessage(`{"user":"olivere"}`)
+	bulk := NewBulkService(nil)
+	for _, id := range []string{"1", "2", "3"} {
+		if err := f(&SearchHit{Type: "tweet", Id: id, Source: &source}, bulk); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if bulk.NumberOfActions() != 2 {
+		t.Errorf("expected %d actions; got: %d", 2, bulk.NumberOfActions())
+	}
+}
+
+func TestReindexerWithBulkRetries(t *testing.T) {
+	var bulkRequests, scrollRequests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/source/_search":
+			fmt.Fprint(w, `{"_scroll_id":"1","hits":{"total":1,"hits":[]}}`)
+		case "/_search/scroll":
+			scrollRequests++
+			if scrollRequests == 1 {
+				fmt.Fprint(w, `{"_scroll_id":"2","hits":{"total":1,"hits":[{"_index":"source","_type":"tweet","_id":"1","_source":{"user":"olivere"}}]}}`)
+			} else {
+				fmt.Fprint(w, `{"_scroll_id":"3","hits":{"total":1,"hits":[]}}`)
+			}
+		case "/_bulk":
+			bulkRequests++
+			if bulkRequests == 1 {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				fmt.Fprint(w, `{"error":"unavailable","status":503}`)
+				return
+			}
+			fmt.Fprint(w, `{"took":1,"errors":false,"items":[{"index":{"_index":"target","_type":"tweet","_id":"1","status":201}}]}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ret, err := NewReindexer(client, "source", CopyToTargetIndex("target")).BulkRetries(2).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bulkRequests != 2 {
+		t.Errorf("expected %d bulk requests; got: %d", 2, bulkRequests)
+	}
+	if ret.Success != 1 {
+		t.Errorf("expected success = %d; got: %d", 1, ret.Success)
+	}
+}
+
+func TestReindexerDoesNotRetryBadBulkRequests(t *testing.T) {
+	var bulkRequests int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/source/_search":
+			fmt.Fprint(w, `{"_scroll_id":"1","hits":{"total":1,"hits":[]}}`)
+		case "/_search/scroll":
+			fmt.Fprint(w, `{"_scroll_id":"2","hits":{"total":1,"hits":[{"_index":"source","_type":"tweet","_id":"1","_source":{"user":"olivere"}}]}}`)
+		case "/_bulk":
+			bulkRequests++
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprint(w, `{"error":"ActionRequestValidationException[Validation Failed: 1: no requests added;]","status":400}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = NewReindexer(client, "source", CopyToTargetIndex("target")).BulkRetries(2).Do()
+	if !IsStatusCode(err, http.StatusBadRequest) {
+		t.Fatalf("expected error with status %d; got: %v", http.StatusBadRequest, err)
+	}
+	if bulkRequests != 1 {
+		t.Errorf("expected %d bulk requests; got: %d", 1, bulkRequests)
+	}
+}
@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"bytes"
+	"compress/gzip"
 	"encoding/json"
 	"io"
 	"io/ioutil"
@@ -41,6 +42,35 @@ func (r *Request) SetBodyString(body string) error {
 	return r.SetBody(strings.NewReader(body))
 }
 
+// SetBodyGzip gzip-compresses the given body and sets the Content-Encoding
+// header accordingly. A string body is sent as-is, all other bodies are
+// serialized as JSON before compression.
+func (r *Request) SetBodyGzip(body interface{}) error {
+	var data []byte
+	switch b := body.(type) {
+	case string:
+		data = []byte(b)
+	default:
+		var err error
+		data, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		r.Header.Set("Content-Type", "application/json")
+	}
+
+	buf := new(bytes.Buffer)
+	w := gzip.NewWriter(buf)
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	r.Header.Set("Content-Encoding", "gzip")
+	return r.SetBody(buf)
+}
+
 func (r *Request) SetBody(body io.Reader) error {
 	rc, ok := body.(io.ReadCloser)
 	if !ok && body != nil {
@@ -51,6 +81,8 @@ func (r *Request) SetBody(body io.Reader) error {
 		switch v := body.(type) {
 		case *strings.Reader:
 			r.ContentLength = int64(v.Len())
+		case *bytes.Reader:
+			r.ContentLength = int64(v.Len())
 		case *bytes.Buffer:
 			r.ContentLength = int64(v.Len())
 		}
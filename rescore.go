@@ -4,25 +4,34 @@
 
 package elastic
 
+// Rescore recomputes the scores of the top hits returned by the query,
+// e.g. with a more expensive query, before they are returned.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-rescore.html.
 type Rescore struct {
 	rescorer                 Rescorer
 	windowSize               *int
 	defaultRescoreWindowSize *int
 }
 
+// NewRescore creates a new Rescore. Use Rescorer to set how the hits
+// are rescored, e.g. with a QueryRescorer.
 func NewRescore() *Rescore {
 	return &Rescore{}
 }
 
+// WindowSize is the number of top hits per shard that are rescored
+// (default: from + size).
 func (r *Rescore) WindowSize(windowSize int) *Rescore {
 	r.windowSize = &windowSize
 	return r
 }
 
+// IsEmpty returns true if no rescorer has been set.
 func (r *Rescore) IsEmpty() bool {
 	return r.rescorer == nil
 }
 
+// Rescorer sets the rescorer to use.
 func (r *Rescore) Rescorer(rescorer Rescorer) *Rescore {
 	r.rescorer = rescorer
 	return r
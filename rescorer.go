@@ -4,6 +4,8 @@
 
 package elastic
 
+// Rescorer is the interface all rescorers implement. Name returns the
+// key of the rescorer in the rescore definition, e.g. "query".
 type Rescorer interface {
 	Name() string
 	Source() interface{}
@@ -11,6 +13,8 @@ type Rescorer interface {
 
 // -- Query Rescorer --
 
+// QueryRescorer rescores hits by combining the original score with the
+// score of a second query.
 type QueryRescorer struct {
 	query              Query
 	rescoreQueryWeight *float64
@@ -18,6 +22,7 @@ type QueryRescorer struct {
 	scoreMode          string
 }
 
+// NewQueryRescorer creates a new QueryRescorer with the given rescore query.
 func NewQueryRescorer(query Query) *QueryRescorer {
 	return &QueryRescorer{
 		query: query,
@@ -28,16 +33,20 @@ func (r *QueryRescorer) Name() string {
 	return "query"
 }
 
+// RescoreQueryWeight is the weight of the rescore query score (default: 1).
 func (r *QueryRescorer) RescoreQueryWeight(rescoreQueryWeight float64) *QueryRescorer {
 	r.rescoreQueryWeight = &rescoreQueryWeight
 	return r
 }
 
+// QueryWeight is the weight of the original query score (default: 1).
 func (r *QueryRescorer) QueryWeight(queryWeight float64) *QueryRescorer {
 	r.queryWeight = &queryWeight
 	return r
 }
 
+// ScoreMode specifies how the scores are combined, i.e. "total"
+// (default), "multiply", "avg", "max" or "min".
 func (r *QueryRescorer) ScoreMode(scoreMode string) *QueryRescorer {
 	r.scoreMode = scoreMode
 	return r
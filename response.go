@@ -5,9 +5,12 @@
 package elastic
 
 import (
+	"compress/gzip"
 	"encoding/json"
+	"io"
 	"io/ioutil"
 	"net/http"
+	"strings"
 )
 
 // Response represents a response from Elasticsearch.
@@ -41,3 +44,40 @@ func (c *Client) newResponse(res *http.Response) (*Response, error) {
 	}
 	return r, nil
 }
+
+// gunzipResponse transparently decompresses the body of a gzip-encoded
+// response. The net/http transport usually does this for us, but not if
+// compression is disabled in the transport or if the request asks for
+// gzip encoding explicitly (see SetGzip).
+func gunzipResponse(res *http.Response) {
+	if res.Body == nil || !strings.EqualFold(res.Header.Get("Content-Encoding"), "gzip") {
+		return
+	}
+	res.Body = &gzipReader{body: res.Body}
+	res.Header.Del("Content-Encoding")
+	res.Header.Del("Content-Length")
+	res.ContentLength = -1
+	res.Uncompressed = true
+}
+
+// gzipReader lazily decompresses a gzip-encoded body on the first read,
+// so empty bodies (e.g. in response to HEAD requests) are no error.
+type gzipReader struct {
+	body io.ReadCloser
+	zr   *gzip.Reader
+	zerr error
+}
+
+func (r *gzipReader) Read(p []byte) (int, error) {
+	if r.zr == nil && r.zerr == nil {
+		r.zr, r.zerr = gzip.NewReader(r.body)
+	}
+	if r.zerr != nil {
+		return 0, r.zerr
+	}
+	return r.zr.Read(p)
+}
+
+func (r *gzipReader) Close() error {
+	return r.body.Close()
+}
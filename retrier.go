@@ -0,0 +1,87 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"net/http"
+	"time"
+)
+
+// Retrier decides whether to retry a failed HTTP request to Elasticsearch.
+// Use SetRetrier to use your own Retrier with a client.
+type Retrier interface {
+	// Retry is called after a request has failed. retry is the number of
+	// failed attempts so far, starting at 1. req is the failed request,
+	// or nil if no connection was available. resp is the response, or nil
+	// if the request failed with a connection error. err is the error
+	// that occurred.
+	//
+	// Retry returns the time to wait before the next attempt and
+	// whether the request should be retried at all.
+	Retry(retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+}
+
+// RetrierFunc is an adapter to allow the use of ordinary functions
+// as a Retrier.
+type RetrierFunc func(retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool)
+
+// Retry calls f(retry, req, resp, err).
+func (f RetrierFunc) Retry(retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	return f(retry, req, resp, err)
+}
+
+// BackoffRetrier is a Retrier that waits exponentially longer between
+// two attempts, starting with an initial wait time and never exceeding
+// a maximum wait time.
+//
+// Requests that failed with a connection error, i.e. where
+// Elasticsearch did not respond, are always retried. Idempotent
+// requests (GET and HEAD) are also retried if Elasticsearch responded
+// with a server error (5xx) or with 429 Too Many Requests. Other error
+// responses, e.g. 400 Bad Request, are never retried.
+//
+// BackoffRetrier is the Retrier used by default, configured with
+// the number of retries set by SetMaxRetries.
+type BackoffRetrier struct {
+	maxRetries  int
+	initialWait time.Duration
+	maxWait     time.Duration
+}
+
+// NewBackoffRetrier creates a new BackoffRetrier. A request is performed
+// at most maxRetries times (and at least once). The time to wait starts
+// at initialWait and doubles with every attempt, up to maxWait.
+func NewBackoffRetrier(maxRetries int, initialWait, maxWait time.Duration) *BackoffRetrier {
+	return &BackoffRetrier{
+		maxRetries:  maxRetries,
+		initialWait: initialWait,
+		maxWait:     maxWait,
+	}
+}
+
+// Retry implements the Retrier interface.
+func (r *BackoffRetrier) Retry(retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+	if retry >= r.maxRetries {
+		return 0, false
+	}
+	if resp != nil {
+		if req != nil && req.Method != "GET" && req.Method != "HEAD" {
+			// Elasticsearch responded, so the request might have had an effect
+			return 0, false
+		}
+		if resp.StatusCode < 500 && resp.StatusCode != http.StatusTooManyRequests {
+			// Sending the same request again won't help
+			return 0, false
+		}
+	}
+	wait := r.initialWait
+	for i := 1; i < retry && wait < r.maxWait; i++ {
+		wait *= 2
+	}
+	if wait > r.maxWait {
+		wait = r.maxWait
+	}
+	return wait, true
+}
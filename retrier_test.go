@@ -0,0 +1,111 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestBackoffRetrier(t *testing.T) {
+	errConn := errors.New("connection reset by peer")
+	get, _ := http.NewRequest("GET", "http://127.0.0.1:9200/_search", nil)
+	post, _ := http.NewRequest("POST", "http://127.0.0.1:9200/twitter/tweet", nil)
+	unavailable := &http.Response{StatusCode: http.StatusServiceUnavailable}
+	badRequest := &http.Response{StatusCode: http.StatusBadRequest}
+	tooManyRequests := &http.Response{StatusCode: http.StatusTooManyRequests}
+
+	tests := []struct {
+		Retry        int
+		Req          *http.Request
+		Resp         *http.Response
+		ExpectedWait time.Duration
+		ExpectedOK   bool
+	}{
+		// Connection errors are retried for all requests
+		{1, get, nil, 100 * time.Millisecond, true},
+		{1, post, nil, 100 * time.Millisecond, true},
+		{1, nil, nil, 100 * time.Millisecond, true},
+		// Server errors and 429 are only retried for idempotent requests
+		{1, get, unavailable, 100 * time.Millisecond, true},
+		{1, get, tooManyRequests, 100 * time.Millisecond, true},
+		{1, get, badRequest, 0, false},
+		{1, post, tooManyRequests, 0, false},
+		{1, post, unavailable, 0, false},
+		{1, post, badRequest, 0, false},
+		// Exponential backoff up to the maximum
+		{2, get, nil, 200 * time.Millisecond, true},
+		{3, get, nil, 400 * time.Millisecond, true},
+		{4, get, nil, 500 * time.Millisecond, true},
+		// Give up after the maximum number of retries
+		{5, get, nil, 0, false},
+		{6, post, nil, 0, false},
+	}
+
+	r := NewBackoffRetrier(5, 100*time.Millisecond, 500*time.Millisecond)
+	for _, test := range tests {
+		wait, ok := r.Retry(test.Retry, test.Req, test.Resp, errConn)
+		if ok != test.ExpectedOK {
+			t.Errorf("retry %d: expected ok = %v; got: %v", test.Retry, test.ExpectedOK, ok)
+		}
+		if wait != test.ExpectedWait {
+			t.Errorf("retry %d: expected wait = %v; got: %v", test.Retry, test.ExpectedWait, wait)
+		}
+	}
+}
+
+func TestPerformRequestWithRetrier(t *testing.T) {
+	var numReqs int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/fail" {
+			// Health checks
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		numReqs++
+		w.WriteHeader(http.StatusServiceUnavailable)
+		w.Write([]byte(`{"error":"ClusterBlockException[blocked by: [SERVICE_UNAVAILABLE/1/state not recovered / initialized];]","status":503}`))
+	}))
+	defer ts.Close()
+
+	var retries []int
+	retrier := RetrierFunc(func(retry int, req *http.Request, resp *http.Response, err error) (time.Duration, bool) {
+		retries = append(retries, retry)
+		return NewBackoffRetrier(3, time.Millisecond, time.Millisecond).Retry(retry, req, resp, err)
+	})
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false), SetHealthcheck(false), SetRetrier(retrier))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Stop()
+
+	// GET is retried on 503
+	if _, err := client.PerformRequest("GET", "/fail", nil, nil); err == nil {
+		t.Fatal("expected error")
+	}
+	if numReqs != 3 {
+		t.Errorf("expected %d requests; got: %d", 3, numReqs)
+	}
+	if len(retries) != 3 {
+		t.Errorf("expected retrier to be called %d times; got: %v", 3, retries)
+	}
+
+	// POST is not retried on 503
+	numReqs = 0
+	retries = nil
+	if _, err := client.PerformRequest("POST", "/fail", nil, "{}"); err == nil {
+		t.Fatal("expected error")
+	}
+	if numReqs != 1 {
+		t.Errorf("expected %d requests; got: %d", 1, numReqs)
+	}
+	if len(retries) != 1 {
+		t.Errorf("expected retrier to be called %d times; got: %v", 1, retries)
+	}
+}
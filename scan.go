@@ -19,7 +19,7 @@ const (
 )
 
 var (
-	// End of stream (or scan)
+	// End of stream (or scan). Use IsEOS to check for it.
 	EOS = errors.New("EOS")
 
 	// No ScrollId
@@ -0,0 +1,108 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// Script holds all the parameters necessary to compile or find in cache
+// and then execute a script.
+//
+// See https://www.elastic.co/guide/en/elasticsearch/reference/current/modules-scripting.html
+// for details of scripting.
+type Script struct {
+	script string
+	typ    string
+	lang   string
+	params map[string]interface{}
+}
+
+// NewScript creates and initializes a new Script with the given
+// inline source, e.g. "ctx._source.counter += count".
+func NewScript(script string) *Script {
+	return &Script{script: script}
+}
+
+// NewScriptInline creates and initializes a new Script with the given
+// inline source. It is the same as NewScript, but sets the type
+// explicitly.
+func NewScriptInline(script string) *Script {
+	return &Script{script: script, typ: "inline"}
+}
+
+// NewScriptStored creates and initializes a new Script that references
+// a script stored in the cluster (indexed script) by its id.
+func NewScriptStored(script string) *Script {
+	return &Script{script: script, typ: "id"}
+}
+
+// NewScriptFile creates and initializes a new Script that references
+// a script file in the config/scripts directory of the nodes.
+func NewScriptFile(script string) *Script {
+	return &Script{script: script, typ: "file"}
+}
+
+// Script sets the source of the script, or the id or file name
+// for stored and file scripts respectively.
+func (s *Script) Script(script string) *Script {
+	s.script = script
+	return s
+}
+
+// Type sets the type of the script: "inline", "id" (for stored scripts)
+// or "file".
+func (s *Script) Type(typ string) *Script {
+	s.typ = typ
+	return s
+}
+
+// Lang sets the language of the script, e.g. "groovy".
+// Elasticsearch uses its default language if it is not set.
+func (s *Script) Lang(lang string) *Script {
+	s.lang = lang
+	return s
+}
+
+// Param adds a single parameter that is passed to the script.
+func (s *Script) Param(name string, value interface{}) *Script {
+	if s.params == nil {
+		s.params = make(map[string]interface{})
+	}
+	s.params[name] = value
+	return s
+}
+
+// Params sets all parameters that are passed to the script.
+// It replaces all parameters set before.
+func (s *Script) Params(params map[string]interface{}) *Script {
+	s.params = params
+	return s
+}
+
+// Source returns the JSON-serializable data of the script. Elasticsearch
+// 1.x expects the script, its lang and params side by side in the body
+// of the request, so Source returns these fields, e.g.
+// {"script_id":"my-script","lang":"groovy","params":{"count":1}}.
+func (s *Script) Source() interface{} {
+	source := make(map[string]interface{})
+	source[scriptKey(s.typ)] = s.script
+	if s.lang != "" {
+		source["lang"] = s.lang
+	}
+	if len(s.params) > 0 {
+		source["params"] = s.params
+	}
+	return source
+}
+
+// scriptKey returns the name of the field that holds a script of the
+// given type in a request to Elasticsearch.
+func scriptKey(typ string) string {
+	switch typ {
+	case "id":
+		return "script_id"
+	case "file":
+		return "script_file"
+	default:
+		return "script"
+	}
+}
@@ -5,24 +5,54 @@
 package elastic
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strings"
+	"time"
 
 	"github.com/olivere/elastic/uritemplates"
 )
 
+const (
+	// defaultScrollSearchType is the search type used by ScrollService
+	// if no other search type is specified.
+	defaultScrollSearchType = "scan"
+
+	// scrollRetryWait is the time to wait before the first retry
+	// in GetNextPage. It is doubled for every subsequent retry.
+	scrollRetryWait = 100 * time.Millisecond
+)
+
+// scrollSearchTypes lists the search types supported by ScrollService.
+var scrollSearchTypes = map[string]bool{
+	"scan":                 true,
+	"query_then_fetch":     true,
+	"dfs_query_then_fetch": true,
+	"count":                true,
+}
+
 // ScrollService manages a cursor through documents in Elasticsearch.
 type ScrollService struct {
-	client    *Client
-	indices   []string
-	types     []string
-	keepAlive string
-	query     Query
-	size      *int
-	pretty    bool
-	scrollId  string
+	client              *Client
+	indices             []string
+	types               []string
+	keepAlive           string
+	query               Query
+	sorters             []Sorter
+	fields              []string
+	routing             []string
+	preference          string
+	fetchSourceContext  *FetchSourceContext
+	size                *int
+	pretty              bool
+	searchType          string
+	scrollId            string
+	sliceId             *int
+	sliceMax            *int
+	maxRetries          int
+	failOnShardFailures bool
 }
 
 func NewScrollService(client *Client) *ScrollService {
@@ -84,29 +114,179 @@ func (s *ScrollService) Query(query Query) *ScrollService {
 	return s
 }
 
+// Sort the results by the given field, in the given order.
+// Use the alternative SortBy to use e.g. a ScoreSort or GeoDistanceSort.
+// Notice that sorting requires a search type other than "scan".
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-sort.html
+// for detailed documentation of sorting.
+func (s *ScrollService) Sort(field string, ascending bool) *ScrollService {
+	s.sorters = append(s.sorters, SortInfo{Field: field, Ascending: ascending})
+	return s
+}
+
+// SortBy defines how to sort results.
+// Use the Sort func for a shortcut.
+// Notice that sorting requires a search type other than "scan".
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-sort.html
+// for detailed documentation of sorting.
+func (s *ScrollService) SortBy(sorter ...Sorter) *ScrollService {
+	s.sorters = append(s.sorters, sorter...)
+	return s
+}
+
+// Routing restricts the scroll to the shards of the given routing values.
+// It is only sent with the request for the first page, as the scroll id
+// determines the shards of all subsequent pages.
+func (s *ScrollService) Routing(routings ...string) *ScrollService {
+	s.routing = append(s.routing, routings...)
+	return s
+}
+
+// Preference specifies the node or shard the scroll should be performed
+// on (default: "random"), e.g. "_primary", "_local", or a custom string.
+// Like Routing, it is only sent with the request for the first page.
+func (s *ScrollService) Preference(preference string) *ScrollService {
+	s.preference = preference
+	return s
+}
+
+// Fields restricts the response to the given stored fields of every hit.
+// It is only sent with the request for the first page, as subsequent
+// pages inherit the fields of the initial search.
+func (s *ScrollService) Fields(fields ...string) *ScrollService {
+	s.fields = append(s.fields, fields...)
+	return s
+}
+
+// FetchSource indicates whether the response should contain the stored
+// _source for every hit.
+func (s *ScrollService) FetchSource(fetchSource bool) *ScrollService {
+	if s.fetchSourceContext == nil {
+		s.fetchSourceContext = NewFetchSourceContext(fetchSource)
+	} else {
+		s.fetchSourceContext.SetFetchSource(fetchSource)
+	}
+	return s
+}
+
+// FetchSourceContext indicates how the _source should be fetched, e.g.
+// to only include some fields of the _source of every hit. Like Fields,
+// it only applies to the request for the first page.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-source-filtering.html.
+func (s *ScrollService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *ScrollService {
+	s.fetchSourceContext = fetchSourceContext
+	return s
+}
+
 func (s *ScrollService) Pretty(pretty bool) *ScrollService {
 	s.pretty = pretty
 	return s
 }
 
+// SearchType sets the search operation type. Valid values are "scan"
+// (the default), "query_then_fetch", "dfs_query_then_fetch", and "count".
+// Use e.g. "query_then_fetch" if you need scored or sorted results,
+// as "scan" disables both. An invalid search type is reported when
+// requesting the first page.
+func (s *ScrollService) SearchType(searchType string) *ScrollService {
+	s.searchType = searchType
+	return s
+}
+
 func (s *ScrollService) Size(size int) *ScrollService {
 	s.size = &size
 	return s
 }
 
+// Slice splits the scroll into max independent slices and restricts
+// this scroll to the slice with the given id. Use it to process a scroll
+// in parallel, e.g. with one ScrollService per worker, each with a
+// distinct id in the range [0, max).
+//
+// Notice that sliced scrolls require Elasticsearch 5.0 or later, which
+// doesn't support the search type "scan" (the default of ScrollService)
+// any more. Use SearchType to pick a different search type.
+func (s *ScrollService) Slice(id, max int) *ScrollService {
+	s.sliceId = &id
+	s.sliceMax = &max
+	return s
+}
+
+// MaxRetries sets the number of times GetNextPage retries to fetch a
+// page after a transient failure, e.g. a network error or a status code
+// of 429 or 5xx. Retries are disabled by default. Other errors are
+// returned immediately.
+func (s *ScrollService) MaxRetries(maxRetries int) *ScrollService {
+	s.maxRetries = maxRetries
+	return s
+}
+
+// FailOnShardFailures makes GetFirstPage and GetNextPage return a
+// *ShardFailuresError if a page could not be fetched from some of
+// the shards, instead of silently returning incomplete pages.
+func (s *ScrollService) FailOnShardFailures(failOnShardFailures bool) *ScrollService {
+	s.failOnShardFailures = failOnShardFailures
+	return s
+}
+
+// ScrollId sets the scroll id to continue scrolling from. You typically
+// don't need to call it: ScrollService keeps track of the scroll id
+// returned by Elasticsearch, so repeated calls to Do page through
+// the results.
 func (s *ScrollService) ScrollId(scrollId string) *ScrollService {
 	s.scrollId = scrollId
 	return s
 }
 
+// CurrentScrollId returns the scroll id that will be used to retrieve
+// the next page. It is empty before the first page has been retrieved.
+func (s *ScrollService) CurrentScrollId() string {
+	return s.scrollId
+}
+
+// Do retrieves the next page of the scroll: the first page on the first
+// call, subsequent pages on all further calls. It returns EOS, and no
+// result, as soon as there are no more hits, so all pages can be
+// processed with:
+//
+//	for {
+//	  res, err := svc.Do()
+//	  if err == elastic.EOS {
+//	    break
+//	  }
+//	  if err != nil {
+//	    // Handle error
+//	  }
+//	  // Work with res.Hits.Hits
+//	}
+//
+// Notice that the first page of a scroll with search type "scan" (the
+// default) doesn't contain any hits, only the total number of hits.
+// Do only returns EOS for it if the query matches no documents at all.
 func (s *ScrollService) Do() (*SearchResult, error) {
+	return s.DoC(context.Background())
+}
+
+// DoC is like Do but binds the request to the given context. If the
+// context is cancelled or times out, the request is aborted and the
+// error of the context is returned.
+func (s *ScrollService) DoC(ctx context.Context) (*SearchResult, error) {
 	if s.scrollId == "" {
-		return s.GetFirstPage()
+		return s.GetFirstPageC(ctx)
 	}
-	return s.GetNextPage()
+	return s.GetNextPageC(ctx)
 }
 
+// GetFirstPage starts the scroll and returns the first page. It returns
+// EOS if there are no hits, following the same contract as Do. In that
+// case, the scroll is cleared in Elasticsearch before EOS is returned.
 func (s *ScrollService) GetFirstPage() (*SearchResult, error) {
+	return s.GetFirstPageC(context.Background())
+}
+
+// GetFirstPageC is like GetFirstPage but binds the request
+// to the given context.
+func (s *ScrollService) GetFirstPageC(ctx context.Context) (*SearchResult, error) {
 	// Build url
 	path := "/"
 
@@ -145,7 +325,17 @@ func (s *ScrollService) GetFirstPage() (*SearchResult, error) {
 
 	// Parameters
 	params := make(url.Values)
-	params.Set("search_type", "scan")
+	if s.searchType != "" {
+		if !scrollSearchTypes[s.searchType] {
+			return nil, fmt.Errorf("elastic: invalid search type %q for scroll", s.searchType)
+		}
+		params.Set("search_type", s.searchType)
+	} else {
+		params.Set("search_type", defaultScrollSearchType)
+	}
+	if params.Get("search_type") == "scan" && len(s.sorters) > 0 {
+		s.client.infof("elastic: sorting is ignored with search type scan")
+	}
 	if s.pretty {
 		params.Set("pretty", fmt.Sprintf("%v", s.pretty))
 	}
@@ -157,15 +347,21 @@ func (s *ScrollService) GetFirstPage() (*SearchResult, error) {
 	if s.size != nil && *s.size > 0 {
 		params.Set("size", fmt.Sprintf("%d", *s.size))
 	}
+	if len(s.routing) > 0 {
+		params.Set("routing", strings.Join(s.routing, ","))
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
 
 	// Set body
-	body := make(map[string]interface{})
-	if s.query != nil {
-		body["query"] = s.query.Source()
+	body, err := s.body()
+	if err != nil {
+		return nil, err
 	}
 
 	// Get response
-	res, err := s.client.PerformRequest("POST", path, params, body)
+	res, err := s.client.PerformRequestC(ctx, "POST", path, params, body)
 	if err != nil {
 		return nil, err
 	}
@@ -176,10 +372,83 @@ func (s *ScrollService) GetFirstPage() (*SearchResult, error) {
 		return nil, err
 	}
 
+	// Continue with the returned scroll id on the next call
+	s.scrollId = searchResult.ScrollId
+
+	if s.failOnShardFailures {
+		if err := checkShardFailures(searchResult.Shards); err != nil {
+			return nil, err
+		}
+	}
+
+	// Determine whether there is anything to scroll through. With search
+	// type scan, the first page never contains hits, only the total.
+	// If not, free the search context right away instead of keeping it
+	// open until it expires.
+	if searchResult.Hits == nil || searchResult.Hits.TotalHits == 0 {
+		s.clearScroll()
+		return nil, EOS
+	}
+	if params.Get("search_type") != "scan" && len(searchResult.Hits.Hits) == 0 {
+		s.clearScroll()
+		return nil, EOS
+	}
+
 	return searchResult, nil
 }
 
+// clearScroll frees the search context of the current scroll id in
+// Elasticsearch. Errors are only logged, as the search context expires
+// after the keep alive time anyway.
+func (s *ScrollService) clearScroll() {
+	if s.scrollId == "" {
+		return
+	}
+	if _, err := s.client.ClearScroll().ScrollId(s.scrollId).Do(); err != nil {
+		s.client.errorf("elastic: failed to clear scroll: %v", err)
+	}
+}
+
+// body returns the body of the request for the first page.
+func (s *ScrollService) body() (interface{}, error) {
+	body := make(map[string]interface{})
+	if s.query != nil {
+		body["query"] = s.query.Source()
+	}
+	if len(s.sorters) > 0 {
+		sortarr := make([]interface{}, 0)
+		for _, sorter := range s.sorters {
+			sortarr = append(sortarr, sorter.Source())
+		}
+		body["sort"] = sortarr
+	}
+	if s.fetchSourceContext != nil {
+		body["_source"] = s.fetchSourceContext.Source()
+	}
+	if len(s.fields) > 0 {
+		body["fields"] = s.fields
+	}
+	if s.sliceId != nil && s.sliceMax != nil {
+		if *s.sliceId < 0 || *s.sliceId >= *s.sliceMax {
+			return nil, fmt.Errorf("elastic: invalid slice %d of %d", *s.sliceId, *s.sliceMax)
+		}
+		body["slice"] = map[string]interface{}{
+			"id":  *s.sliceId,
+			"max": *s.sliceMax,
+		}
+	}
+	return body, nil
+}
+
+// GetNextPage returns the page following the current scroll id.
+// It returns EOS if there are no more hits.
 func (s *ScrollService) GetNextPage() (*SearchResult, error) {
+	return s.GetNextPageC(context.Background())
+}
+
+// GetNextPageC is like GetNextPage but binds the request
+// to the given context.
+func (s *ScrollService) GetNextPageC(ctx context.Context) (*SearchResult, error) {
 	if s.scrollId == "" {
 		return nil, EOS
 	}
@@ -198,10 +467,23 @@ func (s *ScrollService) GetNextPage() (*SearchResult, error) {
 		params.Set("scroll", defaultKeepAlive)
 	}
 
-	// Get response
-	res, err := s.client.PerformRequest("POST", path, params, s.scrollId)
-	if err != nil {
-		return nil, err
+	// Get response, retrying on transient failures
+	var res *Response
+	var err error
+	wait := scrollRetryWait
+	for retry := 0; ; retry++ {
+		res, err = s.client.PerformRequestC(ctx, "POST", path, params, s.scrollId)
+		if err == nil {
+			break
+		}
+		if retry >= s.maxRetries || !isRetryableScrollError(ctx, err) {
+			return nil, err
+		}
+		s.client.errorf("elastic: retrying to get next page of scroll after error: %v", err)
+		if err := sleepC(ctx, wait); err != nil {
+			return nil, err
+		}
+		wait += wait
 	}
 
 	// Return result
@@ -210,6 +492,17 @@ func (s *ScrollService) GetNextPage() (*SearchResult, error) {
 		return nil, err
 	}
 
+	// The scroll id may change from page to page
+	if searchResult.ScrollId != "" {
+		s.scrollId = searchResult.ScrollId
+	}
+
+	if s.failOnShardFailures {
+		if err := checkShardFailures(searchResult.Shards); err != nil {
+			return nil, err
+		}
+	}
+
 	// Determine last page
 	if searchResult == nil || searchResult.Hits == nil || len(searchResult.Hits.Hits) == 0 || searchResult.Hits.TotalHits == 0 {
 		return nil, EOS
@@ -217,3 +510,105 @@ func (s *ScrollService) GetNextPage() (*SearchResult, error) {
 
 	return searchResult, nil
 }
+
+// isRetryableScrollError returns true if a request to get the next page
+// of a scroll failed with err and is worth another try.
+func isRetryableScrollError(ctx context.Context, err error) bool {
+	return ctx.Err() == nil && isTransientError(err)
+}
+
+// Iterator returns a ScrollIterator that pages through all documents
+// of the scroll. It saves the caller from passing the scroll id from
+// one page to the next and checking for EOS manually.
+//
+// Usage:
+//
+//   it := client.Scroll("twitter").Size(100).Iterator()
+//   for it.Next() {
+//     hit := it.Hit()
+//     // Work with hit
+//   }
+//   if err := it.Err(); err != nil {
+//     // Handle error
+//   }
+//
+func (s *ScrollService) Iterator() *ScrollIterator {
+	return &ScrollIterator{service: s}
+}
+
+// ScrollIterator iterates over the hits of a ScrollService,
+// one hit at a time. Create one with ScrollService.Iterator.
+type ScrollIterator struct {
+	service *ScrollService
+	started bool
+	done    bool
+	err     error
+	hits    []*SearchHit
+	hit     *SearchHit
+}
+
+// Next advances the iterator to the next hit. It fetches the next page
+// from Elasticsearch when the hits of the current page are exhausted.
+// Next returns false when there are no more hits or an error occurred;
+// use Err to tell the two cases apart.
+func (it *ScrollIterator) Next() bool {
+	for len(it.hits) == 0 {
+		if it.done || it.err != nil {
+			it.hit = nil
+			return false
+		}
+		if err := it.fetch(); err != nil {
+			if !IsEOS(err) {
+				it.err = err
+			}
+			it.done = true
+			it.hit = nil
+			return false
+		}
+	}
+	it.hit = it.hits[0]
+	it.hits = it.hits[1:]
+	return true
+}
+
+// Hit returns the current hit. It is nil before the first call to Next
+// and after Next returned false.
+func (it *ScrollIterator) Hit() *SearchHit {
+	return it.hit
+}
+
+// Err returns the error that stopped the iteration, if any.
+// Reaching the end of the scroll is not considered an error.
+func (it *ScrollIterator) Err() error {
+	return it.err
+}
+
+// fetch loads the next page of hits. It returns EOS when all
+// documents have been scrolled through.
+func (it *ScrollIterator) fetch() error {
+	var res *SearchResult
+	var err error
+	if !it.started {
+		it.started = true
+		res, err = it.service.GetFirstPage()
+	} else {
+		res, err = it.service.GetNextPage()
+	}
+	if err != nil {
+		return err
+	}
+	if res == nil {
+		return EOS
+	}
+	if res.Hits != nil {
+		it.hits = res.Hits.Hits
+	}
+	if res.ScrollId == "" {
+		// No more pages, but the hits of this one still need to be consumed
+		it.done = true
+		if len(it.hits) == 0 {
+			return EOS
+		}
+	}
+	return nil
+}
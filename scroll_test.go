@@ -5,8 +5,13 @@
 package elastic
 
 import (
+	"context"
 	"encoding/json"
-	_ "net/http"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 )
 
@@ -104,3 +109,424 @@ func TestScroll(t *testing.T) {
 		t.Errorf("expected to retrieve %d hits; got %d", 3, numDocs)
 	}
 }
+
+func TestScrollIterator(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	it := client.Scroll(testIndexName).Type("tweet").Size(1).Iterator()
+
+	numDocs := 0
+	for it.Next() {
+		hit := it.Hit()
+		if hit == nil {
+			t.Fatal("expected hit != nil; got nil")
+		}
+		if hit.Index != testIndexName {
+			t.Errorf("expected SearchResult.Hits.Hit.Index = %q; got %q", testIndexName, hit.Index)
+		}
+		numDocs += 1
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if it.Hit() != nil {
+		t.Errorf("expected hit to be nil after iteration; got %v", it.Hit())
+	}
+	if it.Next() {
+		t.Errorf("expected Next to return false after iteration")
+	}
+
+	if numDocs != 3 {
+		t.Errorf("expected to retrieve %d hits; got %d", 3, numDocs)
+	}
+}
+
+func TestScrollWithCancelledContext(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	res, err := client.Scroll(testIndexName).Size(1).DoC(ctx)
+	if err != context.Canceled {
+		t.Fatalf("expected error %v; got %v", context.Canceled, err)
+	}
+	if res != nil {
+		t.Errorf("expected no results; got %v", res)
+	}
+}
+
+func TestScrollSliceSource(t *testing.T) {
+	s := NewScrollService(nil).Slice(1, 4)
+	body, err := s.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"slice":{"id":1,"max":4}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScrollSliceInvalid(t *testing.T) {
+	tests := []struct {
+		Id, Max int
+	}{
+		{4, 4},
+		{5, 4},
+		{-1, 4},
+	}
+	for _, test := range tests {
+		_, err := NewScrollService(nil).Slice(test.Id, test.Max).body()
+		if err == nil {
+			t.Errorf("expected error for slice %d of %d", test.Id, test.Max)
+		}
+	}
+}
+
+func TestScrollWithInvalidSearchType(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	_, err := client.Scroll(testIndexName).SearchType("query_and_fetch").Do()
+	if err == nil {
+		t.Fatal("expected error for invalid search type")
+	}
+}
+
+func TestScrollWithQueryThenFetch(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	// Other than scan, query_then_fetch returns hits on the first page
+	res, err := client.Scroll(testIndexName).Type("tweet").SearchType("query_then_fetch").Size(1).Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Hits == nil {
+		t.Fatal("expected results.Hits != nil; got nil")
+	}
+	if len(res.Hits.Hits) != 1 {
+		t.Errorf("expected len(results.Hits.Hits) = %d; got %d", 1, len(res.Hits.Hits))
+	}
+}
+
+func TestScrollSortSource(t *testing.T) {
+	s := NewScrollService(nil).
+		SearchType("query_then_fetch").
+		Sort("user", true).
+		SortBy(NewScoreSort(), NewFieldSort("retweets").Desc())
+	body, err := s.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"sort":[{"user":{"order":"asc"}},{"_score":{}},{"retweets":{"order":"desc"}}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScrollFieldsSource(t *testing.T) {
+	s := NewScrollService(nil).
+		Fields("user", "message").
+		FetchSourceContext(NewFetchSourceContext(true).Include("retweets"))
+	body, err := s.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"_source":["retweets"],"fields":["user","message"],"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScrollFetchSourceDisabled(t *testing.T) {
+	s := NewScrollService(nil).FetchSource(false)
+	body, err := s.body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"_source":false,"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScrollWithRepeatedDo(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	svc := client.Scroll(testIndexName).Type("tweet").Size(1)
+	if svc.CurrentScrollId() != "" {
+		t.Fatalf("expected no scrollId before first page; got %q", svc.CurrentScrollId())
+	}
+
+	// The first page remembers the scroll id
+	res, err := svc.Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.ScrollId == "" {
+		t.Fatalf("expected scrollId in results; got %q", res.ScrollId)
+	}
+	if svc.CurrentScrollId() != res.ScrollId {
+		t.Errorf("expected CurrentScrollId = %q; got %q", res.ScrollId, svc.CurrentScrollId())
+	}
+
+	numDocs := 0
+	for {
+		res, err := svc.Do()
+		if err == EOS {
+			break
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+		numDocs += len(res.Hits.Hits)
+	}
+
+	if numDocs != 3 {
+		t.Errorf("expected to retrieve %d hits; got %d", 3, numDocs)
+	}
+}
+
+func TestScrollGetNextPageWithMaxRetries(t *testing.T) {
+	tests := []struct {
+		Status   int
+		Expected int
+	}{
+		{http.StatusServiceUnavailable, 4}, // retried
+		{http.StatusTooManyRequests, 4},    // retried
+		{http.StatusBadRequest, 1},         // not retried
+	}
+
+	for _, test := range tests {
+		var numFailedReqs int
+		status := test.Status
+		fail := func(r *http.Request) (*http.Response, error) {
+			numFailedReqs += 1
+			body := fmt.Sprintf(`{"status":%d,"error":"failed"}`, status)
+			return &http.Response{
+				Request:    r,
+				StatusCode: status,
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+			}, nil
+		}
+		tr := &failingTransport{path: "/_search/scroll", fail: fail}
+		httpClient := &http.Client{Transport: tr}
+
+		client, err := NewClient(SetHttpClient(httpClient))
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		svc := client.Scroll().ScrollId("my-scroll-id").MaxRetries(3)
+		res, err := svc.GetNextPage()
+		if err == nil {
+			t.Fatal("expected error")
+		}
+		if res != nil {
+			t.Fatal("expected no response")
+		}
+		if numFailedReqs != test.Expected {
+			t.Errorf("expected %d requests for status %d; got: %d", test.Expected, test.Status, numFailedReqs)
+		}
+		if svc.CurrentScrollId() != "my-scroll-id" {
+			t.Errorf("expected scroll id to be preserved; got: %q", svc.CurrentScrollId())
+		}
+	}
+}
+
+func TestScrollReturnsEOSConsistently(t *testing.T) {
+	tests := []struct {
+		SearchType  string
+		FirstPage   string
+		ExpectedEOS bool
+	}{
+		// scan returns no hits on the first page, but more pages follow
+		{"", `{"_scroll_id":"1","hits":{"total":3,"hits":[]}}`, false},
+		// scan without any matching documents
+		{"", `{"_scroll_id":"1","hits":{"total":0,"hits":[]}}`, true},
+		// query_then_fetch returns hits on the first page
+		{"query_then_fetch", `{"_scroll_id":"1","hits":{"total":3,"hits":[{"_id":"1"}]}}`, false},
+		// query_then_fetch without any matching documents
+		{"query_then_fetch", `{"_scroll_id":"1","hits":{"total":0,"hits":[]}}`, true},
+	}
+
+	for _, test := range tests {
+		firstPage := test.FirstPage
+		var clearRequests int
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			switch r.URL.Path {
+			case "/":
+				w.WriteHeader(http.StatusOK)
+			case "/twitter/_search":
+				fmt.Fprint(w, firstPage)
+			case "/_search/scroll":
+				if r.Method == "DELETE" {
+					clearRequests++
+					fmt.Fprint(w, `{"succeeded":true,"num_freed":1}`)
+					return
+				}
+				fmt.Fprint(w, `{"_scroll_id":"2","hits":{"total":3,"hits":[]}}`)
+			default:
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+
+		client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+		if err != nil {
+			ts.Close()
+			t.Fatal(err)
+		}
+
+		svc := client.Scroll("twitter").SearchType(test.SearchType)
+		res, err := svc.Do()
+		if test.ExpectedEOS {
+			if err != EOS {
+				t.Errorf("expected EOS for first page %s; got: %v", test.FirstPage, err)
+			}
+			if res != nil {
+				t.Errorf("expected no result with EOS; got: %v", res)
+			}
+			if clearRequests != 1 {
+				t.Errorf("expected the scroll to be cleared once; got: %d", clearRequests)
+			}
+		} else if err != nil {
+			t.Errorf("expected no error for first page %s; got: %v", test.FirstPage, err)
+		} else {
+			// The next page is empty
+			if _, err := svc.Do(); err != EOS {
+				t.Errorf("expected EOS for next page; got: %v", err)
+			}
+		}
+		ts.Close()
+	}
+}
+
+func TestScrollIteratorConsumesLastPage(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/twitter/_search":
+			fmt.Fprint(w, `{"_scroll_id":"1","hits":{"total":2,"hits":[{"_id":"1"}]}}`)
+		default:
+			// The last page comes without a scroll id
+			fmt.Fprint(w, `{"hits":{"total":2,"hits":[{"_id":"2"}]}}`)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	it := client.Scroll("twitter").Iterator()
+	for it.Next() {
+		ids = append(ids, it.Hit().Id)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatal(err)
+	}
+	got := strings.Join(ids, ",")
+	expected := "1,2"
+	if got != expected {
+		t.Errorf("expected hits %q; got: %q", expected, got)
+	}
+	if it.Next() {
+		t.Errorf("expected Next to return false after the last page")
+	}
+}
+
+func TestScrollFailOnShardFailures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/twitter/_search":
+			fmt.Fprint(w, `{"_scroll_id":"1","_shards":{"total":2,"successful":2,"failed":0},"hits":{"total":3,"hits":[]}}`)
+		default:
+			fmt.Fprint(w, `{"_scroll_id":"2","_shards":{"total":2,"successful":1,"failed":1},"hits":{"total":3,"hits":[{"_id":"1"}]}}`)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := client.Scroll("twitter").FailOnShardFailures(true)
+	if _, err := svc.Do(); err != nil {
+		t.Fatalf("expected first page without shard failures; got: %v", err)
+	}
+	res, err := svc.Do()
+	if _, ok := err.(*ShardFailuresError); !ok {
+		t.Fatalf("expected *ShardFailuresError; got: %v", err)
+	}
+	if res != nil {
+		t.Errorf("expected no result; got: %v", res)
+	}
+}
+
+func TestScrollWithRoutingAndPreference(t *testing.T) {
+	var firstPageRouting, nextPageRouting, firstPagePreference string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/":
+			w.WriteHeader(http.StatusOK)
+		case "/twitter/_search":
+			firstPageRouting = r.URL.Query().Get("routing")
+			firstPagePreference = r.URL.Query().Get("preference")
+			fmt.Fprint(w, `{"_scroll_id":"1","hits":{"total":1,"hits":[]}}`)
+		case "/_search/scroll":
+			nextPageRouting = r.URL.Query().Get("routing")
+			fmt.Fprint(w, `{"_scroll_id":"2","hits":{"total":1,"hits":[]}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	svc := client.Scroll("twitter").Routing("olivere", "sandrae").Preference("_shards:2,3|_primary")
+	if _, err := svc.Do(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Do(); err != EOS {
+		t.Fatalf("expected EOS; got: %v", err)
+	}
+	if firstPageRouting != "olivere,sandrae" {
+		t.Errorf("expected routing %q on first page; got: %q", "olivere,sandrae", firstPageRouting)
+	}
+	if firstPagePreference != "_shards:2,3|_primary" {
+		t.Errorf("expected preference %q on first page; got: %q", "_shards:2,3|_primary", firstPagePreference)
+	}
+	if nextPageRouting != "" {
+		t.Errorf("expected no routing on next page; got: %q", nextPageRouting)
+	}
+}
@@ -16,16 +16,17 @@ import (
 
 // Search for documents in Elasticsearch.
 type SearchService struct {
-	client       *Client
-	searchSource *SearchSource
-	source       interface{}
-	pretty       bool
-	searchType   string
-	indices      []string
-	queryHint    string
-	routing      string
-	preference   string
-	types        []string
+	client              *Client
+	searchSource        *SearchSource
+	source              interface{}
+	pretty              bool
+	searchType          string
+	indices             []string
+	queryHint           string
+	routing             string
+	preference          string
+	types               []string
+	failOnShardFailures bool
 }
 
 // NewSearchService creates a new service for searching in Elasticsearch.
@@ -86,7 +87,7 @@ func (s *SearchService) Type(typ string) *SearchService {
 // Types allows to restrict the search to a list of types.
 func (s *SearchService) Types(types ...string) *SearchService {
 	if s.types == nil {
-		s.types = make([]string, len(types))
+		s.types = make([]string, 0)
 	}
 	s.types = append(s.types, types...)
 	return s
@@ -98,6 +99,14 @@ func (s *SearchService) Pretty(pretty bool) *SearchService {
 	return s
 }
 
+// FailOnShardFailures makes Do return a *ShardFailuresError if the search
+// failed on some of the shards. By default, Do returns the results of
+// the successful shards and reports the failures in SearchResult.Shards.
+func (s *SearchService) FailOnShardFailures(failOnShardFailures bool) *SearchService {
+	s.failOnShardFailures = failOnShardFailures
+	return s
+}
+
 // Timeout sets the timeout to use, e.g. "1s" or "1000ms".
 func (s *SearchService) Timeout(timeout string) *SearchService {
 	s.searchSource = s.searchSource.Timeout(timeout)
@@ -127,7 +136,10 @@ func (s *SearchService) Routing(routing string) *SearchService {
 }
 
 // Preference specifies the node or shard the operation should be
-// performed on (default: "random").
+// performed on (default: "random"), e.g. "_primary", "_local", or a
+// custom string like a session id. Using the same custom string for
+// all requests of a user serves them from the same shard copies,
+// which keeps the order of results consistent while paginating.
 func (s *SearchService) Preference(preference string) *SearchService {
 	s.preference = preference
 	return s
@@ -145,7 +157,10 @@ func (s *SearchService) Query(query Query) *SearchService {
 }
 
 // PostFilter is executed as the last filter. It only affects the
-// search hits but not facets. See
+// search hits but not facets or aggregations, which makes it useful
+// for faceted navigation. As Query and Filter share the same method
+// set, a query (e.g. a TermQuery) can be passed as well, which is
+// required by Elasticsearch 5.0 and later. See
 // http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-post-filter.html
 // for details.
 func (s *SearchService) PostFilter(postFilter Filter) *SearchService {
@@ -234,6 +249,39 @@ func (s *SearchService) Version(version bool) *SearchService {
 	return s
 }
 
+// TerminateAfter specifies the maximum number of documents to collect for
+// each shard, upon reaching which the query execution will terminate early.
+// SearchResult.TerminatedEarly reports whether that happened.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-body.html.
+func (s *SearchService) TerminateAfter(terminateAfter int) *SearchService {
+	s.searchSource = s.searchSource.TerminateAfter(terminateAfter)
+	return s
+}
+
+// SearchAfter returns the hits that follow the hit with the given sort
+// values, typically the sort values of the last hit of the previous page
+// (see SearchResult.LastSortValues).
+//
+// Use SearchAfter instead of From and Size to page very deep into the
+// results without keeping a scroll context open. It must be combined with
+// a deterministic sort, e.g. on a unique field as a tie breaker, and
+// From must be 0 (or unset). Notice that search_after requires
+// Elasticsearch 5.0 or later.
+// See https://www.elastic.co/guide/en/elasticsearch/reference/5.0/search-request-search-after.html.
+func (s *SearchService) SearchAfter(sortValues ...interface{}) *SearchService {
+	s.searchSource = s.searchSource.SearchAfter(sortValues...)
+	return s
+}
+
+// Rescorer adds a rescorer that recomputes the scores of the top hits,
+// e.g. with a more expensive phrase query. Multiple rescorers are
+// executed in the order they were added.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-rescore.html.
+func (s *SearchService) Rescorer(rescore *Rescore) *SearchService {
+	s.searchSource = s.searchSource.AddRescore(rescore)
+	return s
+}
+
 // Sort the results by the given field, in the given order.
 // Use the alternative SortWithInfo to use a struct to define the sorting.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-sort.html
@@ -268,6 +316,36 @@ func (s *SearchService) Fields(fields ...string) *SearchService {
 	return s
 }
 
+// ScriptFields adds fields that are computed by a script for every hit,
+// returned in SearchHit.Fields.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-script-fields.html.
+func (s *SearchService) ScriptFields(scriptFields ...*ScriptField) *SearchService {
+	s.searchSource = s.searchSource.ScriptFields(scriptFields...)
+	return s
+}
+
+// ScriptField adds a single field that is computed by a script for every hit.
+func (s *SearchService) ScriptField(scriptField *ScriptField) *SearchService {
+	s.searchSource = s.searchSource.ScriptField(scriptField)
+	return s
+}
+
+// FetchSource indicates whether the response should contain the stored
+// _source for every hit.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-source-filtering.html.
+func (s *SearchService) FetchSource(fetchSource bool) *SearchService {
+	s.searchSource = s.searchSource.FetchSource(fetchSource)
+	return s
+}
+
+// FetchSourceContext indicates how the _source should be fetched, e.g.
+// to only include or exclude some fields of the _source of every hit.
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-request-source-filtering.html.
+func (s *SearchService) FetchSourceContext(fetchSourceContext *FetchSourceContext) *SearchService {
+	s.searchSource = s.searchSource.FetchSourceContext(fetchSourceContext)
+	return s
+}
+
 // Do executes the search and returns a SearchResult.
 func (s *SearchService) Do() (*SearchResult, error) {
 	// Build url
@@ -313,6 +391,12 @@ func (s *SearchService) Do() (*SearchResult, error) {
 	if s.searchType != "" {
 		params.Set("search_type", s.searchType)
 	}
+	if s.routing != "" {
+		params.Set("routing", s.routing)
+	}
+	if s.preference != "" {
+		params.Set("preference", s.preference)
+	}
 
 	// Perform request
 	var body interface{}
@@ -331,19 +415,27 @@ func (s *SearchService) Do() (*SearchResult, error) {
 	if err := json.Unmarshal(res.Body, ret); err != nil {
 		return nil, err
 	}
+	if s.failOnShardFailures {
+		if err := checkShardFailures(ret.Shards); err != nil {
+			return nil, err
+		}
+	}
 	return ret, nil
 }
 
 // SearchResult is the result of a search in Elasticsearch.
 type SearchResult struct {
-	TookInMillis int64         `json:"took"`            // search time in milliseconds
-	ScrollId     string        `json:"_scroll_id"`      // only used with Scroll and Scan operations
-	Hits         *SearchHits   `json:"hits"`            // the actual search hits
-	Suggest      SearchSuggest `json:"suggest"`         // results from suggesters
-	Facets       SearchFacets  `json:"facets"`          // results from facets
-	Aggregations Aggregations  `json:"aggregations"`    // results from aggregations
-	TimedOut     bool          `json:"timed_out"`       // true if the search timed out
-	Error        string        `json:"error,omitempty"` // used in MultiSearch only
+	TookInMillis    int64         `json:"took"`                       // search time in milliseconds
+	ScrollId        string        `json:"_scroll_id"`                 // only used with Scroll and Scan operations
+	Hits            *SearchHits   `json:"hits"`                       // the actual search hits
+	Suggest         SearchSuggest `json:"suggest"`                    // results from suggesters
+	Facets          SearchFacets  `json:"facets"`                     // results from facets
+	Aggregations    Aggregations  `json:"aggregations"`               // results from aggregations
+	TimedOut        bool          `json:"timed_out"`                  // true if the search timed out
+	Shards          *ShardsInfo   `json:"_shards,omitempty"`          // number of total, successful and failed shards, and the failures
+	TerminatedEarly bool          `json:"terminated_early,omitempty"` // true if the search stopped early because of TerminateAfter
+	Error           string        `json:"error,omitempty"`            // used in MultiSearch only
+	Status          int           `json:"status,omitempty"`           // used in MultiSearch only
 }
 
 // TotalHits is a convenience function to return the number of hits for
@@ -355,6 +447,16 @@ func (r *SearchResult) TotalHits() int64 {
 	return 0
 }
 
+// LastSortValues returns the sort values of the last hit of the search
+// result, or nil if there are no hits. Pass them to SearchAfter to get
+// the next page of results.
+func (r *SearchResult) LastSortValues() []interface{} {
+	if r.Hits == nil || len(r.Hits.Hits) == 0 {
+		return nil
+	}
+	return r.Hits.Hits[len(r.Hits.Hits)-1].Sort
+}
+
 // Each is a utility function to iterate over all hits. It saves you from
 // checking for nil values. Notice that Each will ignore errors in
 // serializing JSON.
@@ -372,6 +474,26 @@ func (r *SearchResult) Each(typ reflect.Type) []interface{} {
 	return slice
 }
 
+// ShardsInfo summarizes on how many shards an operation was performed,
+// and why it failed on some of them.
+type ShardsInfo struct {
+	Total      int             `json:"total"`
+	Successful int             `json:"successful"`
+	Failed     int             `json:"failed"`
+	Failures   []*ShardFailure `json:"failures,omitempty"`
+}
+
+// ShardFailure describes why an operation failed on a shard.
+type ShardFailure struct {
+	Index  string `json:"index,omitempty"`
+	Shard  int    `json:"shard"`
+	Node   string `json:"node,omitempty"`
+	Status int    `json:"status,omitempty"`
+	// Reason is a string with Elasticsearch 1.x and an object
+	// with e.g. "type" and "reason" as of Elasticsearch 2.0.
+	Reason interface{} `json:"reason,omitempty"`
+}
+
 // SearchHits specifies the list of search hits.
 type SearchHits struct {
 	TotalHits int64        `json:"total"`     // total number of hits found
@@ -430,10 +552,18 @@ type SearchSuggestion struct {
 // SearchSuggestionOption is an option of a SearchSuggestion.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-suggesters.html.
 type SearchSuggestionOption struct {
-	Text    string      `json:"text"`
-	Score   float32     `json:"score"`
-	Freq    int         `json:"freq"`
-	Payload interface{} `json:"payload"`
+	Text        string      `json:"text"`
+	Highlighted string      `json:"highlighted,omitempty"`
+	Score       float32     `json:"score"`
+	Freq        int         `json:"freq"`
+	Payload     interface{} `json:"payload"`
+
+	// Index, Type, Id and Source are returned by completion suggesters
+	// in Elasticsearch 5.0 and later.
+	Index  string           `json:"_index,omitempty"`
+	Type   string           `json:"_type,omitempty"`
+	Id     string           `json:"_id,omitempty"`
+	Source *json.RawMessage `json:"_source,omitempty"`
 }
 
 // Facets
@@ -7,6 +7,8 @@ package elastic
 import (
 	"bytes"
 	"encoding/json"
+	"sort"
+	"strconv"
 )
 
 // Aggregations can be seen as a unit-of-work that build
@@ -81,11 +83,29 @@ func (a Aggregations) Avg(name string) (*AggregationValueMetric, bool) {
 	return nil, false
 }
 
-// ValueCount returns value-count aggregation results.
+// ValueCount returns the number of values counted by a value-count
+// aggregation.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-metrics-valuecount-aggregation.html
-func (a Aggregations) ValueCount(name string) (*AggregationValueMetric, bool) {
+func (a Aggregations) ValueCount(name string) (int64, bool) {
 	if raw, found := a[name]; found {
-		agg := new(AggregationValueMetric)
+		if raw == nil {
+			return 0, true
+		}
+		var agg struct {
+			Value int64 `json:"value"`
+		}
+		if err := json.Unmarshal(*raw, &agg); err == nil {
+			return agg.Value, true
+		}
+	}
+	return 0, false
+}
+
+// ScriptedMetric returns scripted metric aggregation results.
+// See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-metrics-scripted-metric-aggregation.html
+func (a Aggregations) ScriptedMetric(name string) (*AggregationScriptedMetric, bool) {
+	if raw, found := a[name]; found {
+		agg := new(AggregationScriptedMetric)
 		if raw == nil {
 			return agg, true
 		}
@@ -246,7 +266,8 @@ func (a Aggregations) Missing(name string) (*AggregationSingleBucket, bool) {
 	return nil, false
 }
 
-// Nested returns nested results.
+// Nested returns nested results. Use the Aggregations of the
+// result to get the results of sub-aggregations.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-nested-aggregation.html
 func (a Aggregations) Nested(name string) (*AggregationSingleBucket, bool) {
 	if raw, found := a[name]; found {
@@ -464,6 +485,30 @@ func (a *AggregationValueMetric) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// -- Scripted metric --
+
+// AggregationScriptedMetric is the result of a ScriptedMetric aggregation.
+// Value is whatever the reduce script returned, decoded from JSON,
+// e.g. a float64, a string, a []interface{} or a map[string]interface{}.
+type AggregationScriptedMetric struct {
+	Aggregations
+
+	Value interface{} //`json:"value"`
+}
+
+// UnmarshalJSON decodes JSON data and initializes an AggregationScriptedMetric structure.
+func (a *AggregationScriptedMetric) UnmarshalJSON(data []byte) error {
+	var aggs map[string]*json.RawMessage
+	if err := json.Unmarshal(data, &aggs); err != nil {
+		return err
+	}
+	if v, ok := aggs["value"]; ok && v != nil {
+		json.Unmarshal(*v, &a.Value)
+	}
+	a.Aggregations = aggs
+	return nil
+}
+
 // -- Stats metric --
 
 // AggregationStatsMetric is a multi-value metric, returned by a Stats aggregation.
@@ -568,7 +613,22 @@ func (a *AggregationPercentilesMetric) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	if v, ok := aggs["values"]; ok && v != nil {
-		json.Unmarshal(*v, &a.Values)
+		// Elasticsearch returns "NaN" (as a string) for percentiles
+		// of empty buckets, so we can't decode into floats directly
+		var values map[string]interface{}
+		if err := json.Unmarshal(*v, &values); err == nil {
+			a.Values = make(map[string]float64, len(values))
+			for key, value := range values {
+				switch value := value.(type) {
+				case float64:
+					a.Values[key] = value
+				case string:
+					if f, err := strconv.ParseFloat(value, 64); err == nil {
+						a.Values[key] = f
+					}
+				}
+			}
+		}
 	}
 	a.Aggregations = aggs
 	return nil
@@ -675,12 +735,50 @@ func (a *AggregationBucketRangeItems) UnmarshalJSON(data []byte) error {
 		json.Unmarshal(*v, &a.SumOfOtherDocCount)
 	}
 	if v, ok := aggs["buckets"]; ok && v != nil {
-		json.Unmarshal(*v, &a.Buckets)
+		if err := json.Unmarshal(*v, &a.Buckets); err != nil {
+			// Keyed range aggregations return buckets as an object
+			// with the key of each bucket as the name of its field
+			var keyed map[string]*AggregationBucketRangeItem
+			if err := json.Unmarshal(*v, &keyed); err == nil {
+				a.Buckets = make([]*AggregationBucketRangeItem, 0, len(keyed))
+				for key, bucket := range keyed {
+					if bucket == nil {
+						continue
+					}
+					if bucket.Key == "" {
+						bucket.Key = key
+					}
+					a.Buckets = append(a.Buckets, bucket)
+				}
+				sort.Sort(aggregationBucketRangeItemsByRange(a.Buckets))
+			}
+		}
 	}
 	a.Aggregations = aggs
 	return nil
 }
 
+// aggregationBucketRangeItemsByRange sorts range buckets the way
+// Elasticsearch does, i.e. by from and then by to, where a missing
+// from comes first and a missing to comes last.
+type aggregationBucketRangeItemsByRange []*AggregationBucketRangeItem
+
+func (b aggregationBucketRangeItemsByRange) Len() int      { return len(b) }
+func (b aggregationBucketRangeItemsByRange) Swap(i, j int) { b[i], b[j] = b[j], b[i] }
+func (b aggregationBucketRangeItemsByRange) Less(i, j int) bool {
+	if b[i].From == nil || b[j].From == nil {
+		if b[i].From != nil || b[j].From != nil {
+			return b[i].From == nil
+		}
+	} else if *b[i].From != *b[j].From {
+		return *b[i].From < *b[j].From
+	}
+	if b[i].To == nil || b[j].To == nil {
+		return b[i].To != nil && b[j].To == nil
+	}
+	return *b[i].To < *b[j].To
+}
+
 // AggregationBucketRangeItem is a single bucket of an AggregationBucketRangeItems structure.
 type AggregationBucketRangeItem struct {
 	Aggregations
@@ -756,9 +854,10 @@ func (a *AggregationBucketKeyItems) UnmarshalJSON(data []byte) error {
 type AggregationBucketKeyItem struct {
 	Aggregations
 
-	Key       interface{} //`json:"key"`
-	KeyNumber json.Number
-	DocCount  int64 //`json:"doc_count"`
+	Key         interface{} //`json:"key"`
+	KeyAsString *string     //`json:"key_as_string"`
+	KeyNumber   json.Number
+	DocCount    int64 //`json:"doc_count"`
 }
 
 // UnmarshalJSON decodes JSON data and initializes an AggregationBucketKeyItem structure.
@@ -773,6 +872,9 @@ func (a *AggregationBucketKeyItem) UnmarshalJSON(data []byte) error {
 		json.Unmarshal(*v, &a.Key)
 		json.Unmarshal(*v, &a.KeyNumber)
 	}
+	if v, ok := aggs["key_as_string"]; ok && v != nil {
+		json.Unmarshal(*v, &a.KeyAsString)
+	}
 	if v, ok := aggs["doc_count"]; ok && v != nil {
 		json.Unmarshal(*v, &a.DocCount)
 	}
@@ -824,7 +926,10 @@ func (a *AggregationBucketSignificantTerm) UnmarshalJSON(data []byte) error {
 		return err
 	}
 	if v, ok := aggs["key"]; ok && v != nil {
-		json.Unmarshal(*v, &a.Key)
+		if err := json.Unmarshal(*v, &a.Key); err != nil {
+			// Numeric fields return numeric keys
+			a.Key = string(*v)
+		}
 	}
 	if v, ok := aggs["doc_count"]; ok && v != nil {
 		json.Unmarshal(*v, &a.DocCount)
@@ -21,6 +21,7 @@ type CardinalityAggregation struct {
 	rehash             *bool
 }
 
+// NewCardinalityAggregation creates a new cardinality aggregation.
 func NewCardinalityAggregation() CardinalityAggregation {
 	a := CardinalityAggregation{
 		params:          make(map[string]interface{}),
@@ -29,6 +30,7 @@ func NewCardinalityAggregation() CardinalityAggregation {
 	return a
 }
 
+// Field sets the field to count the distinct values of.
 func (a CardinalityAggregation) Field(field string) CardinalityAggregation {
 	a.field = field
 	return a
@@ -64,11 +66,16 @@ func (a CardinalityAggregation) SubAggregation(name string, subAggregation Aggre
 	return a
 }
 
+// PrecisionThreshold sets the count below which the counts are expected
+// to be close to accurate. Higher values use more memory. It is omitted
+// by default, i.e. Elasticsearch picks a default threshold.
 func (a CardinalityAggregation) PrecisionThreshold(threshold int64) CardinalityAggregation {
 	a.precisionThreshold = &threshold
 	return a
 }
 
+// Rehash indicates whether the values need to be hashed before counting.
+// Set it to false if the field already contains hashes.
 func (a CardinalityAggregation) Rehash(rehash bool) CardinalityAggregation {
 	a.rehash = &rehash
 	return a
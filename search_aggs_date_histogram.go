@@ -21,6 +21,7 @@ type DateHistogramAggregation struct {
 	minDocCount                *int64
 	extendedBoundsMin          interface{}
 	extendedBoundsMax          interface{}
+	timeZone                   string
 	preZone                    string
 	postZone                   string
 	preZoneAdjustLargeInterval *bool
@@ -63,6 +64,7 @@ func (a DateHistogramAggregation) Param(name string, value interface{}) DateHist
 	return a
 }
 
+// SubAggregation adds a sub-aggregation that is computed for every bucket.
 func (a DateHistogramAggregation) SubAggregation(name string, subAggregation Aggregation) DateHistogramAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -157,11 +159,21 @@ func (a DateHistogramAggregation) OrderByAggregationAndMetric(aggName, metric st
 	return a
 }
 
+// MinDocCount sets the minimum number of documents a bucket must have
+// to be returned. Use 0 to return empty buckets.
 func (a DateHistogramAggregation) MinDocCount(minDocCount int64) DateHistogramAggregation {
 	a.minDocCount = &minDocCount
 	return a
 }
 
+// TimeZone sets the time zone used for bucketing and rounding,
+// e.g. "+01:00" or "Europe/Berlin". It requires Elasticsearch 1.5 or
+// later; use PreZone and PostZone with earlier versions.
+func (a DateHistogramAggregation) TimeZone(timeZone string) DateHistogramAggregation {
+	a.timeZone = timeZone
+	return a
+}
+
 func (a DateHistogramAggregation) PreZone(preZone string) DateHistogramAggregation {
 	a.preZone = preZone
 	return a
@@ -258,6 +270,9 @@ func (a DateHistogramAggregation) Source() interface{} {
 		}
 		opts["order"] = o
 	}
+	if a.timeZone != "" {
+		opts["time_zone"] = a.timeZone
+	}
 	if a.preZone != "" {
 		opts["pre_zone"] = a.preZone
 	}
@@ -21,3 +21,17 @@ func TestDateHistogramAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestDateHistogramAggregationWithTimeZone(t *testing.T) {
+	agg := NewDateHistogramAggregation().Field("ts").Interval("1d").TimeZone("+01:00").MinDocCount(0)
+	agg = agg.SubAggregation("avg_retweets", NewAvgAggregation().Field("retweets"))
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"avg_retweets":{"avg":{"field":"retweets"}}},"date_histogram":{"field":"ts","interval":"1d","min_doc_count":0,"time_zone":"+01:00"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -35,6 +35,7 @@ type DateRangeAggregationEntry struct {
 	To   interface{}
 }
 
+// NewDateRangeAggregation creates a new DateRangeAggregation.
 func NewDateRangeAggregation() DateRangeAggregation {
 	a := DateRangeAggregation{
 		params:          make(map[string]interface{}),
@@ -44,6 +45,7 @@ func NewDateRangeAggregation() DateRangeAggregation {
 	return a
 }
 
+// Field sets the field to bucket on.
 func (a DateRangeAggregation) Field(field string) DateRangeAggregation {
 	a.field = field
 	return a
@@ -74,6 +76,8 @@ func (a DateRangeAggregation) SubAggregation(name string, subAggregation Aggrega
 	return a
 }
 
+// Keyed makes Elasticsearch return the buckets as an object that maps
+// the key of each range to its bucket instead of as an array.
 func (a DateRangeAggregation) Keyed(keyed bool) DateRangeAggregation {
 	a.keyed = &keyed
 	return a
@@ -84,21 +88,29 @@ func (a DateRangeAggregation) Unmapped(unmapped bool) DateRangeAggregation {
 	return a
 }
 
+// Format sets the date format used for from_as_string and to_as_string
+// in the response, as well as for date strings in the ranges.
 func (a DateRangeAggregation) Format(format string) DateRangeAggregation {
 	a.format = format
 	return a
 }
 
+// AddRange adds a range from (inclusive) to (exclusive). Both may be
+// a time.Time, a number (milliseconds since the epoch), or a string in
+// date math, e.g. "now-10M/M". Pass nil for an unbounded range.
 func (a DateRangeAggregation) AddRange(from, to interface{}) DateRangeAggregation {
 	a.entries = append(a.entries, DateRangeAggregationEntry{From: from, To: to})
 	return a
 }
 
+// AddRangeWithKey adds a range like AddRange, but with a key that
+// names the resulting bucket.
 func (a DateRangeAggregation) AddRangeWithKey(key string, from, to interface{}) DateRangeAggregation {
 	a.entries = append(a.entries, DateRangeAggregationEntry{Key: key, From: from, To: to})
 	return a
 }
 
+// AddUnboundedTo adds a range that starts at from and has no upper bound.
 func (a DateRangeAggregation) AddUnboundedTo(from interface{}) DateRangeAggregation {
 	a.entries = append(a.entries, DateRangeAggregationEntry{From: from, To: nil})
 	return a
@@ -109,6 +121,7 @@ func (a DateRangeAggregation) AddUnboundedToWithKey(key string, from interface{}
 	return a
 }
 
+// AddUnboundedFrom adds a range that has no lower bound and ends at to.
 func (a DateRangeAggregation) AddUnboundedFrom(to interface{}) DateRangeAggregation {
 	a.entries = append(a.entries, DateRangeAggregationEntry{From: nil, To: to})
 	return a
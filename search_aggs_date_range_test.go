@@ -104,3 +104,19 @@ func TestDateRangeAggregationWithSpecialNames(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestDateRangeAggregationWithFormat(t *testing.T) {
+	agg := NewDateRangeAggregation().Field("created_at").
+		Format("MM-yyy").
+		AddRange(nil, "now-10M/M").
+		AddRange("now-10M/M", nil)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"date_range":{"field":"created_at","format":"MM-yyy","ranges":[{"to":"now-10M/M"},{"from":"now-10M/M"}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -14,6 +14,9 @@ type FilterAggregation struct {
 	subAggregations map[string]Aggregation
 }
 
+// NewFilterAggregation creates a new FilterAggregation.
+// Use Filter to set the filter. As filters and queries share the
+// same interface, you can pass a query as well.
 func NewFilterAggregation() FilterAggregation {
 	a := FilterAggregation{
 		subAggregations: make(map[string]Aggregation),
@@ -21,11 +24,14 @@ func NewFilterAggregation() FilterAggregation {
 	return a
 }
 
+// SubAggregation adds a sub-aggregation that is computed over all
+// documents that match the filter.
 func (a FilterAggregation) SubAggregation(name string, subAggregation Aggregation) FilterAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
 }
 
+// Filter sets the filter that documents need to match.
 func (a FilterAggregation) Filter(filter Filter) FilterAggregation {
 	a.filter = filter
 	return a
@@ -38,3 +38,17 @@ func TestFilterAggregationWithSubAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFilterAggregationWithQuery(t *testing.T) {
+	agg := NewFilterAggregation().Filter(NewTermQuery("color", "red")).
+		SubAggregation("avg_price", NewAvgAggregation().Field("price"))
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"avg_price":{"avg":{"field":"price"}}},"filter":{"term":{"color":"red"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
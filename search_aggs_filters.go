@@ -7,24 +7,37 @@ package elastic
 // FiltersAggregation defines a multi bucket aggregations where each bucket
 // is associated with a filter. Each bucket will collect all documents that
 // match its associated filter.
+//
+// Filters are either anonymous (see Filter and Filters) or named
+// (see FilterWithName). Elasticsearch doesn't allow to mix both, so if
+// there is at least one named filter, the anonymous filters are ignored.
+// Anonymous filters return their buckets in AggregationBucketFilters.Buckets,
+// named filters return their buckets in AggregationBucketFilters.NamedBuckets.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-filters-aggregation.html
 type FiltersAggregation struct {
 	filters         []Filter
+	namedFilters    map[string]Filter
+	otherBucket     *bool
+	otherBucketKey  string
 	subAggregations map[string]Aggregation
 }
 
+// NewFiltersAggregation creates a new FiltersAggregation.
 func NewFiltersAggregation() FiltersAggregation {
 	return FiltersAggregation{
 		filters:         make([]Filter, 0),
+		namedFilters:    make(map[string]Filter),
 		subAggregations: make(map[string]Aggregation),
 	}
 }
 
+// Filter adds an anonymous filter.
 func (a FiltersAggregation) Filter(filter Filter) FiltersAggregation {
 	a.filters = append(a.filters, filter)
 	return a
 }
 
+// Filters adds anonymous filters.
 func (a FiltersAggregation) Filters(filters ...Filter) FiltersAggregation {
 	if len(filters) > 0 {
 		a.filters = append(a.filters, filters...)
@@ -32,6 +45,31 @@ func (a FiltersAggregation) Filters(filters ...Filter) FiltersAggregation {
 	return a
 }
 
+// FilterWithName adds a filter with the given name. The name is used
+// as the key of the resulting bucket.
+func (a FiltersAggregation) FilterWithName(name string, filter Filter) FiltersAggregation {
+	a.namedFilters[name] = filter
+	return a
+}
+
+// OtherBucket adds a bucket to the response that collects all documents
+// that match none of the filters. It is named "_other_" unless changed
+// with OtherBucketKey. This is only supported with Elasticsearch 2.0
+// or later.
+func (a FiltersAggregation) OtherBucket(otherBucket bool) FiltersAggregation {
+	a.otherBucket = &otherBucket
+	return a
+}
+
+// OtherBucketKey sets the key of the bucket returned with OtherBucket.
+// Setting it implicitly enables OtherBucket. This is only supported
+// with Elasticsearch 2.0 or later.
+func (a FiltersAggregation) OtherBucketKey(otherBucketKey string) FiltersAggregation {
+	a.otherBucketKey = otherBucketKey
+	return a
+}
+
+// SubAggregation adds a sub-aggregation that is computed for every bucket.
 func (a FiltersAggregation) SubAggregation(name string, subAggregation Aggregation) FiltersAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -57,11 +95,26 @@ func (a FiltersAggregation) Source() interface{} {
 	filters := make(map[string]interface{})
 	source["filters"] = filters
 
-	arr := make([]interface{}, len(a.filters))
-	for i, filter := range a.filters {
-		arr[i] = filter.Source()
+	if len(a.namedFilters) > 0 {
+		dict := make(map[string]interface{})
+		for name, filter := range a.namedFilters {
+			dict[name] = filter.Source()
+		}
+		filters["filters"] = dict
+	} else {
+		arr := make([]interface{}, len(a.filters))
+		for i, filter := range a.filters {
+			arr[i] = filter.Source()
+		}
+		filters["filters"] = arr
+	}
+
+	if a.otherBucket != nil {
+		filters["other_bucket"] = *a.otherBucket
+	}
+	if a.otherBucketKey != "" {
+		filters["other_bucket_key"] = a.otherBucketKey
 	}
-	filters["filters"] = arr
 
 	// AggregationBuilder (SubAggregations)
 	if len(a.subAggregations) > 0 {
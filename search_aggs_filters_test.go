@@ -39,3 +39,39 @@ func TestFiltersAggregationWithSubAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFiltersAggregationWithNamedFilters(t *testing.T) {
+	f1 := NewTermFilter("body", "error")
+	f2 := NewTermFilter("body", "warning")
+	agg := NewFiltersAggregation().
+		FilterWithName("errors", f1).
+		FilterWithName("warnings", f2)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filters":{"filters":{"errors":{"term":{"body":"error"}},"warnings":{"term":{"body":"warning"}}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFiltersAggregationWithOtherBucket(t *testing.T) {
+	f1 := NewTermFilter("body", "error")
+	f2 := NewTermQuery("body", "warning")
+	agg := NewFiltersAggregation().
+		FilterWithName("errors", f1).
+		FilterWithName("warnings", f2).
+		OtherBucket(true).
+		OtherBucketKey("other_messages")
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filters":{"filters":{"errors":{"term":{"body":"error"}},"warnings":{"term":{"body":"warning"}}},"other_bucket":true,"other_bucket_key":"other_messages"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -54,6 +54,8 @@ func (a GeoBoundsAggregation) Param(name string, value interface{}) GeoBoundsAgg
 	return a
 }
 
+// WrapLongitude specifies whether the bounding box is allowed to overlap
+// the international date line (default: true).
 func (a GeoBoundsAggregation) WrapLongitude(wrapLongitude bool) GeoBoundsAggregation {
 	a.wrapLongitude = &wrapLongitude
 	return a
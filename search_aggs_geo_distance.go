@@ -17,6 +17,7 @@ type GeoDistanceAggregation struct {
 	unit            string
 	distanceType    string
 	point           string
+	origin          *GeoPoint
 	ranges          []geoDistAggRange
 	subAggregations map[string]Aggregation
 }
@@ -40,6 +41,7 @@ func (a GeoDistanceAggregation) Field(field string) GeoDistanceAggregation {
 	return a
 }
 
+// Unit sets the distance unit of the ranges, e.g. "km" (default: "m").
 func (a GeoDistanceAggregation) Unit(unit string) GeoDistanceAggregation {
 	a.unit = unit
 	return a
@@ -50,8 +52,23 @@ func (a GeoDistanceAggregation) DistanceType(distanceType string) GeoDistanceAgg
 	return a
 }
 
+// Point sets the origin as a string formatted as "{lat},{lon}",
+// e.g. "52.3760,4.894".
 func (a GeoDistanceAggregation) Point(latLon string) GeoDistanceAggregation {
 	a.point = latLon
+	a.origin = nil
+	return a
+}
+
+// Origin sets the origin the distances are computed from.
+func (a GeoDistanceAggregation) Origin(lat, lon float64) GeoDistanceAggregation {
+	return a.OriginFromGeoPoint(GeoPointFromLatLon(lat, lon))
+}
+
+// OriginFromGeoPoint sets the origin the distances are computed from.
+func (a GeoDistanceAggregation) OriginFromGeoPoint(point *GeoPoint) GeoDistanceAggregation {
+	a.origin = point
+	a.point = ""
 	return a
 }
 
@@ -60,6 +77,8 @@ func (a GeoDistanceAggregation) SubAggregation(name string, subAggregation Aggre
 	return a
 }
 
+// AddRange adds a bucket for distances from (inclusive) to to (exclusive).
+// Pass nil for from or to to leave the range unbounded.
 func (a GeoDistanceAggregation) AddRange(from, to interface{}) GeoDistanceAggregation {
 	a.ranges = append(a.ranges, geoDistAggRange{From: from, To: to})
 	return a
@@ -118,7 +137,7 @@ func (a GeoDistanceAggregation) Source() interface{} {
 	//    }
 	// }
 	//
-	// This method returns only the { "range" : { ... } } part.
+	// This method returns only the { "geo_distance" : { ... } } part.
 
 	source := make(map[string]interface{})
 	opts := make(map[string]interface{})
@@ -133,7 +152,9 @@ func (a GeoDistanceAggregation) Source() interface{} {
 	if a.distanceType != "" {
 		opts["distance_type"] = a.distanceType
 	}
-	if a.point != "" {
+	if a.origin != nil {
+		opts["origin"] = a.origin.Source()
+	} else if a.point != "" {
 		opts["origin"] = a.point
 	}
 
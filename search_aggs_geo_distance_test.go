@@ -40,3 +40,18 @@ func TestGeoDistanceAggregationWithUnbounded(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoDistanceAggregationWithOrigin(t *testing.T) {
+	agg := NewGeoDistanceAggregation().Field("location").Origin(52.376, 4.894).Unit("km")
+	agg = agg.AddRange(nil, 5)
+	agg = agg.AddRangeWithKey("far", 5, nil)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_distance":{"field":"location","origin":{"lat":52.376,"lon":4.894},"ranges":[{"to":5},{"from":5,"key":"far"}],"unit":"km"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
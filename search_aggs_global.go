@@ -13,6 +13,8 @@ type GlobalAggregation struct {
 	subAggregations map[string]Aggregation
 }
 
+// NewGlobalAggregation creates a new GlobalAggregation. It has no
+// parameters; use SubAggregation to compute metrics over all documents.
 func NewGlobalAggregation() GlobalAggregation {
 	a := GlobalAggregation{
 		subAggregations: make(map[string]Aggregation),
@@ -20,6 +22,7 @@ func NewGlobalAggregation() GlobalAggregation {
 	return a
 }
 
+// SubAggregation adds an aggregation that is computed over all documents.
 func (a GlobalAggregation) SubAggregation(name string, subAggregation Aggregation) GlobalAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -21,3 +21,16 @@ func TestGlobalAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGlobalAggregationWithSubAggregation(t *testing.T) {
+	agg := NewGlobalAggregation().SubAggregation("all_categories", NewTermsAggregation().Field("category"))
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"all_categories":{"terms":{"field":"category"}}},"global":{}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -58,11 +58,14 @@ func (a HistogramAggregation) Param(name string, value interface{}) HistogramAgg
 	return a
 }
 
+// SubAggregation adds a sub-aggregation that is computed for every bucket.
 func (a HistogramAggregation) SubAggregation(name string, subAggregation Aggregation) HistogramAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
 }
 
+// Interval sets the interval of the buckets. Elasticsearch 1.x only
+// supports integer intervals for histograms.
 func (a HistogramAggregation) Interval(interval int64) HistogramAggregation {
 	a.interval = interval
 	return a
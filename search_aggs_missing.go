@@ -17,6 +17,7 @@ type MissingAggregation struct {
 	subAggregations map[string]Aggregation
 }
 
+// NewMissingAggregation creates a new MissingAggregation.
 func NewMissingAggregation() MissingAggregation {
 	a := MissingAggregation{
 		subAggregations: make(map[string]Aggregation),
@@ -24,11 +25,14 @@ func NewMissingAggregation() MissingAggregation {
 	return a
 }
 
+// Field is the field to check for missing values.
 func (a MissingAggregation) Field(field string) MissingAggregation {
 	a.field = field
 	return a
 }
 
+// SubAggregation adds an aggregation that is computed over the documents
+// missing the field.
 func (a MissingAggregation) SubAggregation(name string, subAggregation Aggregation) MissingAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
@@ -21,3 +21,16 @@ func TestMissingAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestMissingAggregationWithSubAggregation(t *testing.T) {
+	agg := NewMissingAggregation().Field("price").SubAggregation("by_category", NewTermsAggregation().Field("category"))
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"by_category":{"terms":{"field":"category"}}},"missing":{"field":"price"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
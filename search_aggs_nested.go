@@ -12,6 +12,8 @@ type NestedAggregation struct {
 	subAggregations map[string]Aggregation
 }
 
+// NewNestedAggregation creates a new NestedAggregation.
+// Use Path to set the path of the nested documents.
 func NewNestedAggregation() NestedAggregation {
 	a := NestedAggregation{
 		subAggregations: make(map[string]Aggregation),
@@ -19,11 +21,15 @@ func NewNestedAggregation() NestedAggregation {
 	return a
 }
 
+// SubAggregation adds a sub-aggregation that is computed over the
+// nested documents. Fields in the sub-aggregation need to be qualified
+// with the path, e.g. "resellers.price".
 func (a NestedAggregation) SubAggregation(name string, subAggregation Aggregation) NestedAggregation {
 	a.subAggregations[name] = subAggregation
 	return a
 }
 
+// Path sets the path of the nested documents, e.g. "resellers".
 func (a NestedAggregation) Path(path string) NestedAggregation {
 	a.path = path
 	return a
@@ -63,6 +63,7 @@ func (a PercentileRanksAggregation) SubAggregation(name string, subAggregation A
 	return a
 }
 
+// Values sets the values to compute the percentile ranks of.
 func (a PercentileRanksAggregation) Values(values ...float64) PercentileRanksAggregation {
 	a.values = make([]float64, 0)
 	a.values = append(a.values, values...)
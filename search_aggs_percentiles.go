@@ -63,6 +63,13 @@ func (a PercentilesAggregation) SubAggregation(name string, subAggregation Aggre
 	return a
 }
 
+// Percents sets the percentiles to compute, e.g. 50, 95, and 99.
+// The default is 1, 5, 25, 50, 75, 95, and 99.
+func (a PercentilesAggregation) Percents(percents ...float64) PercentilesAggregation {
+	return a.Percentiles(percents...)
+}
+
+// Percentiles is an alias of Percents.
 func (a PercentilesAggregation) Percentiles(percentiles ...float64) PercentilesAggregation {
 	a.percentiles = make([]float64, 0)
 	a.percentiles = append(a.percentiles, percentiles...)
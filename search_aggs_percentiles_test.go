@@ -35,6 +35,19 @@ func TestPercentilesAggregationWithCustomPercents(t *testing.T) {
 	}
 }
 
+func TestPercentilesAggregationWithPercents(t *testing.T) {
+	agg := NewPercentilesAggregation().Field("load_time").Percents(95, 99, 99.9)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"percentiles":{"field":"load_time","percents":[95,99,99.9]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestPercentilesAggregationWithFormat(t *testing.T) {
 	agg := NewPercentilesAggregation().Field("price").Format("00000.00")
 	data, err := json.Marshal(agg.Source())
@@ -33,6 +33,7 @@ type rangeAggregationEntry struct {
 	To   interface{}
 }
 
+// NewRangeAggregation creates a new RangeAggregation.
 func NewRangeAggregation() RangeAggregation {
 	a := RangeAggregation{
 		params:          make(map[string]interface{}),
@@ -42,6 +43,7 @@ func NewRangeAggregation() RangeAggregation {
 	return a
 }
 
+// Field sets the field to bucket on.
 func (a RangeAggregation) Field(field string) RangeAggregation {
 	a.field = field
 	return a
@@ -72,6 +74,8 @@ func (a RangeAggregation) SubAggregation(name string, subAggregation Aggregation
 	return a
 }
 
+// Keyed makes Elasticsearch return the buckets as an object that maps
+// the key of each range to its bucket instead of as an array.
 func (a RangeAggregation) Keyed(keyed bool) RangeAggregation {
 	a.keyed = &keyed
 	return a
@@ -82,16 +86,21 @@ func (a RangeAggregation) Unmapped(unmapped bool) RangeAggregation {
 	return a
 }
 
+// AddRange adds a range from (inclusive) to (exclusive). Pass nil
+// as from or to for an unbounded range.
 func (a RangeAggregation) AddRange(from, to interface{}) RangeAggregation {
 	a.entries = append(a.entries, rangeAggregationEntry{From: from, To: to})
 	return a
 }
 
+// AddRangeWithKey adds a range like AddRange, but with a key that
+// names the resulting bucket.
 func (a RangeAggregation) AddRangeWithKey(key string, from, to interface{}) RangeAggregation {
 	a.entries = append(a.entries, rangeAggregationEntry{Key: key, From: from, To: to})
 	return a
 }
 
+// AddUnboundedTo adds a range that starts at from and has no upper bound.
 func (a RangeAggregation) AddUnboundedTo(from interface{}) RangeAggregation {
 	a.entries = append(a.entries, rangeAggregationEntry{From: from, To: nil})
 	return a
@@ -102,6 +111,7 @@ func (a RangeAggregation) AddUnboundedToWithKey(key string, from interface{}) Ra
 	return a
 }
 
+// AddUnboundedFrom adds a range that has no lower bound and ends at to.
 func (a RangeAggregation) AddUnboundedFrom(to interface{}) RangeAggregation {
 	a.entries = append(a.entries, rangeAggregationEntry{From: nil, To: to})
 	return a
@@ -94,3 +94,19 @@ func TestRangeAggregationWithKeys(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestRangeAggregationWithNilBounds(t *testing.T) {
+	agg := NewRangeAggregation().Field("price").
+		AddRange(nil, 100).
+		AddRange(100, 500).
+		AddUnboundedTo(500)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"range":{"field":"price","ranges":[{"to":100},{"from":100,"to":500},{"from":500}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// ScriptedMetricAggregation is a metric aggregation that executes using
+// scripts to provide a metric output. The init script runs once per shard
+// before any documents are collected, the map script once per document,
+// the combine script once per shard after collection and the reduce
+// script once on the coordinating node to produce the final result.
+// Only the map script is required.
+// See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-metrics-scripted-metric-aggregation.html
+type ScriptedMetricAggregation struct {
+	initScript    string
+	mapScript     string
+	combineScript string
+	reduceScript  string
+	lang          string
+	params        map[string]interface{}
+	reduceParams  map[string]interface{}
+}
+
+// NewScriptedMetricAggregation creates a new ScriptedMetricAggregation.
+func NewScriptedMetricAggregation() ScriptedMetricAggregation {
+	a := ScriptedMetricAggregation{
+		params:       make(map[string]interface{}),
+		reduceParams: make(map[string]interface{}),
+	}
+	return a
+}
+
+// InitScript is executed once per shard before any documents are
+// collected, e.g. to set up an initial state in _agg.
+func (a ScriptedMetricAggregation) InitScript(initScript string) ScriptedMetricAggregation {
+	a.initScript = initScript
+	return a
+}
+
+// MapScript is executed once per collected document.
+func (a ScriptedMetricAggregation) MapScript(mapScript string) ScriptedMetricAggregation {
+	a.mapScript = mapScript
+	return a
+}
+
+// CombineScript is executed once per shard after document collection
+// is complete.
+func (a ScriptedMetricAggregation) CombineScript(combineScript string) ScriptedMetricAggregation {
+	a.combineScript = combineScript
+	return a
+}
+
+// ReduceScript is executed once on the coordinating node after all
+// shards have returned their results. Its return value is the value
+// of the aggregation.
+func (a ScriptedMetricAggregation) ReduceScript(reduceScript string) ScriptedMetricAggregation {
+	a.reduceScript = reduceScript
+	return a
+}
+
+// Lang is the language of the scripts, e.g. "groovy".
+func (a ScriptedMetricAggregation) Lang(lang string) ScriptedMetricAggregation {
+	a.lang = lang
+	return a
+}
+
+// Param adds a parameter that is passed to the init, map and combine scripts.
+func (a ScriptedMetricAggregation) Param(name string, value interface{}) ScriptedMetricAggregation {
+	// Copy the parameters so that aggregations derived from the same
+	// value do not share them.
+	params := copyScriptParams(a.params)
+	params[name] = value
+	a.params = params
+	return a
+}
+
+// Params sets the parameters that are passed to the init, map and combine
+// scripts. It replaces all parameters set before.
+func (a ScriptedMetricAggregation) Params(params map[string]interface{}) ScriptedMetricAggregation {
+	a.params = copyScriptParams(params)
+	return a
+}
+
+// ReduceParams sets the parameters that are passed to the reduce script.
+func (a ScriptedMetricAggregation) ReduceParams(reduceParams map[string]interface{}) ScriptedMetricAggregation {
+	a.reduceParams = copyScriptParams(reduceParams)
+	return a
+}
+
+func (a ScriptedMetricAggregation) Source() interface{} {
+	// Example:
+	//	{
+	//    "aggs" : {
+	//      "profit" : {
+	//        "scripted_metric" : {
+	//          "init_script" : "_agg['transactions'] = []",
+	//          "map_script" : "...",
+	//          "combine_script" : "...",
+	//          "reduce_script" : "..."
+	//        }
+	//      }
+	//    }
+	//	}
+	// This method returns only the { "scripted_metric" : { ... } } part.
+
+	source := make(map[string]interface{})
+	opts := make(map[string]interface{})
+	source["scripted_metric"] = opts
+
+	if a.initScript != "" {
+		opts["init_script"] = a.initScript
+	}
+	if a.mapScript != "" {
+		opts["map_script"] = a.mapScript
+	}
+	if a.combineScript != "" {
+		opts["combine_script"] = a.combineScript
+	}
+	if a.reduceScript != "" {
+		opts["reduce_script"] = a.reduceScript
+	}
+	if a.lang != "" {
+		opts["lang"] = a.lang
+	}
+	if len(a.params) > 0 {
+		opts["params"] = a.params
+	}
+	if len(a.reduceParams) > 0 {
+		opts["reduce_params"] = a.reduceParams
+	}
+
+	return source
+}
+
+// copyScriptParams returns a shallow copy of params.
+func copyScriptParams(params map[string]interface{}) map[string]interface{} {
+	m := make(map[string]interface{}, len(params))
+	for k, v := range params {
+		m[k] = v
+	}
+	return m
+}
@@ -0,0 +1,67 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestScriptedMetricAggregation(t *testing.T) {
+	agg := NewScriptedMetricAggregation().
+		InitScript("_agg['transactions'] = []").
+		MapScript("if (doc['type'].value == \"sale\") { _agg.transactions.add(doc['amount'].value) }").
+		CombineScript("profit = 0; for (t in _agg.transactions) { profit += t }; return profit").
+		ReduceScript("profit = 0; for (a in _aggs) { profit += a }; return profit")
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"scripted_metric":{"combine_script":"profit = 0; for (t in _agg.transactions) { profit += t }; return profit","init_script":"_agg['transactions'] = []","map_script":"if (doc['type'].value == \"sale\") { _agg.transactions.add(doc['amount'].value) }","reduce_script":"profit = 0; for (a in _aggs) { profit += a }; return profit"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestScriptedMetricAggregationDoesNotShareParams(t *testing.T) {
+	params := map[string]interface{}{"factor": 2}
+	base := NewScriptedMetricAggregation().MapScript("...").Params(params)
+	a := base.Param("a", 1)
+	b := base.Param("b", 2)
+	for agg, expected := range map[*ScriptedMetricAggregation]string{
+		&base: `{"scripted_metric":{"map_script":"...","params":{"factor":2}}}`,
+		&a:    `{"scripted_metric":{"map_script":"...","params":{"a":1,"factor":2}}}`,
+		&b:    `{"scripted_metric":{"map_script":"...","params":{"b":2,"factor":2}}}`,
+	} {
+		data, err := json.Marshal(agg.Source())
+		if err != nil {
+			t.Fatalf("marshaling to JSON failed: %v", err)
+		}
+		if got := string(data); got != expected {
+			t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+		}
+	}
+	if len(params) != 1 {
+		t.Errorf("expected caller's params to be left alone; got: %v", params)
+	}
+}
+
+func TestScriptedMetricAggregationWithParams(t *testing.T) {
+	agg := NewScriptedMetricAggregation().
+		MapScript("_agg.count = (_agg.count ?: 0) + factor").
+		Lang("groovy").
+		Param("factor", 2).
+		ReduceParams(map[string]interface{}{"scale": 10})
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"scripted_metric":{"lang":"groovy","map_script":"_agg.count = (_agg.count ?: 0) + factor","params":{"factor":2},"reduce_params":{"scale":10}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -4,7 +4,7 @@
 
 package elastic
 
-// SignificantSignificantTermsAggregation is an aggregation that returns interesting
+// SignificantTermsAggregation is an aggregation that returns interesting
 // or unusual occurrences of terms in a set.
 // See: http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-aggregations-bucket-significantterms-aggregation.html
 type SignificantTermsAggregation struct {
@@ -19,6 +19,7 @@ type SignificantTermsAggregation struct {
 	executionHint    string
 }
 
+// NewSignificantTermsAggregation creates a new SignificantTermsAggregation.
 func NewSignificantTermsAggregation() SignificantTermsAggregation {
 	a := SignificantTermsAggregation{
 		subAggregations: make(map[string]Aggregation, 0),
@@ -26,6 +27,7 @@ func NewSignificantTermsAggregation() SignificantTermsAggregation {
 	return a
 }
 
+// Field sets the field to find significant terms in.
 func (a SignificantTermsAggregation) Field(field string) SignificantTermsAggregation {
 	a.field = field
 	return a
@@ -46,6 +48,12 @@ func (a SignificantTermsAggregation) ShardMinDocCount(shardMinDocCount int) Sign
 	return a
 }
 
+// Size sets the number of terms to return. It is an alias of RequiredSize.
+func (a SignificantTermsAggregation) Size(size int) SignificantTermsAggregation {
+	return a.RequiredSize(size)
+}
+
+// RequiredSize sets the number of terms to return.
 func (a SignificantTermsAggregation) RequiredSize(requiredSize int) SignificantTermsAggregation {
 	a.requiredSize = &requiredSize
 	return a
@@ -56,6 +64,10 @@ func (a SignificantTermsAggregation) ShardSize(shardSize int) SignificantTermsAg
 	return a
 }
 
+// BackgroundFilter narrows down the background set that the frequency
+// of terms is compared against. The background set is the whole index
+// by default. As filters and queries share the same interface, you can
+// pass a query as well.
 func (a SignificantTermsAggregation) BackgroundFilter(filter Filter) SignificantTermsAggregation {
 	a.filter = filter
 	return a
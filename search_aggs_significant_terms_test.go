@@ -54,3 +54,19 @@ func TestSignificantTermsAggregationSubAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestSignificantTermsAggregationWithSizeAndBackgroundQuery(t *testing.T) {
+	agg := NewSignificantTermsAggregation().
+		Field("tag").
+		Size(20).
+		BackgroundFilter(NewTermQuery("category", "books"))
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"significant_terms":{"background_filter":{"term":{"category":"books"}},"field":"tag","size":20}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
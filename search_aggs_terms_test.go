@@ -55,3 +55,16 @@ func TestTermsAggregationWithMultipleSubAggregation(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTermsAggregationOrderByCountDesc(t *testing.T) {
+	agg := NewTermsAggregation().Field("status").Size(10).OrderByCountDesc()
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"field":"status","order":{"_count":"desc"},"size":10}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"encoding/json"
+	"math"
 	"strings"
 	"testing"
 	"time"
@@ -401,14 +402,8 @@ func TestAggs(t *testing.T) {
 	if !found {
 		t.Errorf("expected %v; got: %v", true, found)
 	}
-	if valueCountAggRes == nil {
-		t.Fatalf("expected != nil; got: nil")
-	}
-	if valueCountAggRes.Value == nil {
-		t.Fatalf("expected != nil; got: %v", *valueCountAggRes.Value)
-	}
-	if *valueCountAggRes.Value != 3.0 {
-		t.Errorf("expected %v; got: %v", 3.0, *valueCountAggRes.Value)
+	if valueCountAggRes != 3 {
+		t.Errorf("expected %v; got: %v", 3, valueCountAggRes)
 	}
 
 	// percentilesRetweets
@@ -976,6 +971,75 @@ func TestAggsAvg(t *testing.T) {
 	}
 }
 
+func TestAggsMetricsInTermsBuckets(t *testing.T) {
+	s := `{
+	"users" : {
+	  "buckets" : [ {
+	    "key" : "olivere",
+	    "doc_count" : 2,
+	    "avg_retweets" : { "value" : 54 },
+	    "retweets_stats" : { "count" : 2, "min" : 0, "max" : 108, "avg" : 54, "sum" : 108 }
+	  }, {
+	    "key" : "sandrae",
+	    "doc_count" : 0,
+	    "avg_retweets" : { "value" : null },
+	    "retweets_stats" : { "count" : 0, "min" : null, "max" : null, "avg" : null, "sum" : null }
+	  } ]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Terms("users")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 2 {
+		t.Fatalf("expected %d bucket entries; got: %d", 2, len(agg.Buckets))
+	}
+
+	avg, found := agg.Buckets[0].Avg("avg_retweets")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if avg.Value == nil || *avg.Value != float64(54) {
+		t.Errorf("expected sub-aggregation value = %v; got: %v", float64(54), avg.Value)
+	}
+	stats, found := agg.Buckets[0].Stats("retweets_stats")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if stats.Count != 2 {
+		t.Errorf("expected count = %d; got: %d", 2, stats.Count)
+	}
+	if stats.Max == nil || *stats.Max != float64(108) {
+		t.Errorf("expected max = %v; got: %v", float64(108), stats.Max)
+	}
+
+	// Empty buckets have no values
+	avg, found = agg.Buckets[1].Avg("avg_retweets")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if avg.Value != nil {
+		t.Errorf("expected sub-aggregation value = nil; got: %v", *avg.Value)
+	}
+	stats, found = agg.Buckets[1].Stats("retweets_stats")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if stats.Count != 0 {
+		t.Errorf("expected count = %d; got: %d", 0, stats.Count)
+	}
+	if stats.Min != nil {
+		t.Errorf("expected min = nil; got: %v", *stats.Min)
+	}
+}
+
 func TestAggsValueCount(t *testing.T) {
 	s := `{
 	"grades_count": {
@@ -989,18 +1053,12 @@ func TestAggsValueCount(t *testing.T) {
 		t.Fatalf("expected no error decoding; got: %v", err)
 	}
 
-	agg, found := aggs.ValueCount("grades_count")
+	count, found := aggs.ValueCount("grades_count")
 	if !found {
 		t.Fatalf("expected aggregation to be found; got: %v", found)
 	}
-	if agg == nil {
-		t.Fatalf("expected aggregation != nil; got: %v", agg)
-	}
-	if agg.Value == nil {
-		t.Fatalf("expected aggregation value != nil; got: %v", agg.Value)
-	}
-	if *agg.Value != float64(10) {
-		t.Fatalf("expected aggregation value = %v; got: %v", float64(10), *agg.Value)
+	if count != 10 {
+		t.Fatalf("expected aggregation value = %v; got: %v", 10, count)
 	}
 }
 
@@ -1216,6 +1274,40 @@ func TestAggsPercentiles(t *testing.T) {
 	}
 }
 
+func TestAggsPercentilesWithNaN(t *testing.T) {
+	s := `{
+  "load_time_outlier": {
+		"values" : {
+		  "50.0": "NaN",
+		  "99.0": 150
+		}
+  }
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Percentiles("load_time_outlier")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if len(agg.Values) != 2 {
+		t.Fatalf("expected %d aggregation Values; got: %d", 2, len(agg.Values))
+	}
+	if !math.IsNaN(agg.Values["50.0"]) {
+		t.Errorf("expected aggregation value for \"50.0\" = NaN; got: %v", agg.Values["50.0"])
+	}
+	if agg.Values["99.0"] != float64(150) {
+		t.Errorf("expected aggregation value for \"99.0\" = %v; got: %v", float64(150), agg.Values["99.0"])
+	}
+}
+
 func TestAggsPercentilRanks(t *testing.T) {
 	s := `{
   "load_time_outlier": {
@@ -1701,6 +1793,56 @@ func TestAggsNested(t *testing.T) {
 	}
 }
 
+func TestAggsNestedInTermsBuckets(t *testing.T) {
+	s := `{
+	"products": {
+		"buckets": [
+			{
+				"key": "tv",
+				"doc_count": 2,
+				"offers": {
+					"doc_count": 5,
+					"avg_price": {
+						"value": 420.5
+					}
+				}
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	products, found := aggs.Terms("products")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(products.Buckets) != 1 {
+		t.Fatalf("expected %d buckets; got: %d", 1, len(products.Buckets))
+	}
+	offers, found := products.Buckets[0].Nested("offers")
+	if !found {
+		t.Fatalf("expected sub-aggregation to be found; got: %v", found)
+	}
+	if offers.DocCount != 5 {
+		t.Errorf("expected sub-aggregation DocCount = %d; got: %d", 5, offers.DocCount)
+	}
+	avgPrice, found := offers.Avg("avg_price")
+	if !found {
+		t.Fatalf("expected nested sub-aggregation to be found; got: %v", found)
+	}
+	if avgPrice.Value == nil {
+		t.Fatalf("expected nested sub-aggregation value != nil; got: %v", avgPrice.Value)
+	}
+	if *avgPrice.Value != float64(420.5) {
+		t.Errorf("expected nested sub-aggregation value = %v; got: %v", float64(420.5), *avgPrice.Value)
+	}
+}
+
 func TestAggsReverseNested(t *testing.T) {
 	s := `{
 	"comment_to_issue": {
@@ -1857,6 +1999,41 @@ func TestAggsTermsWithNumericKeys(t *testing.T) {
 	}
 }
 
+func TestAggsTermsWithKeyAsString(t *testing.T) {
+	s := `{
+	"created" : {
+	  "buckets" : [ {
+	    "key" : 1325376000000,
+	    "key_as_string" : "2012-01-01T00:00:00.000Z",
+	    "doc_count" : 3
+	  } ]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Terms("created")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 1 {
+		t.Fatalf("expected %d bucket entries; got: %d", 1, len(agg.Buckets))
+	}
+	if agg.Buckets[0].KeyAsString == nil {
+		t.Fatalf("expected key_as_string != nil; got: %v", agg.Buckets[0].KeyAsString)
+	}
+	if *agg.Buckets[0].KeyAsString != "2012-01-01T00:00:00.000Z" {
+		t.Errorf("expected key_as_string %q; got: %q", "2012-01-01T00:00:00.000Z", *agg.Buckets[0].KeyAsString)
+	}
+	if agg.Buckets[0].DocCount != 3 {
+		t.Errorf("expected doc count %d; got: %d", 3, agg.Buckets[0].DocCount)
+	}
+}
+
 func TestAggsTermsWithBoolKeys(t *testing.T) {
 	s := `{
 	"users" : {
@@ -1956,6 +2133,48 @@ func TestAggsSignificantTerms(t *testing.T) {
 	}
 }
 
+func TestAggsSignificantTermsWithNumericKeys(t *testing.T) {
+	s := `{
+	"significant_ages" : {
+		"doc_count": 47347,
+		"buckets" : [
+			{
+				"key": 42,
+				"doc_count": 3640,
+				"score": 0.371235374214817,
+				"bg_count": 66799
+			}
+		]
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.SignificantTerms("significant_ages")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if len(agg.Buckets) != 1 {
+		t.Fatalf("expected %d buckets; got: %d", 1, len(agg.Buckets))
+	}
+	if agg.Buckets[0].Key != "42" {
+		t.Errorf("expected Key = %q; got: %q", "42", agg.Buckets[0].Key)
+	}
+	if agg.Buckets[0].DocCount != 3640 {
+		t.Errorf("expected DocCount = %d; got: %d", 3640, agg.Buckets[0].DocCount)
+	}
+	if agg.Buckets[0].BgCount != 66799 {
+		t.Errorf("expected BgCount = %d; got: %d", 66799, agg.Buckets[0].BgCount)
+	}
+	if agg.Buckets[0].Score != float64(0.371235374214817) {
+		t.Errorf("expected Score = %v; got: %v", float64(0.371235374214817), agg.Buckets[0].Score)
+	}
+}
+
 func TestAggsRange(t *testing.T) {
 	s := `{
 	"price_ranges" : {
@@ -2037,6 +2256,72 @@ func TestAggsRange(t *testing.T) {
 	}
 }
 
+func TestAggsRangeKeyed(t *testing.T) {
+	s := `{
+	"price_ranges" : {
+		"buckets": {
+			"cheap": {
+				"to": 100,
+				"doc_count": 2
+			},
+			"expensive": {
+				"from": 500,
+				"doc_count": 1
+			},
+			"average": {
+				"from": 100,
+				"to": 500,
+				"doc_count": 4
+			}
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Range("price_ranges")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if len(agg.Buckets) != 3 {
+		t.Fatalf("expected %d buckets; got: %d", 3, len(agg.Buckets))
+	}
+	if agg.Buckets[0].Key != "cheap" {
+		t.Errorf("expected Key = %q; got: %q", "cheap", agg.Buckets[0].Key)
+	}
+	if agg.Buckets[0].From != nil {
+		t.Errorf("expected From = nil; got: %v", *agg.Buckets[0].From)
+	}
+	if agg.Buckets[0].To == nil || *agg.Buckets[0].To != float64(100) {
+		t.Errorf("expected To = %v; got: %v", float64(100), agg.Buckets[0].To)
+	}
+	if agg.Buckets[0].DocCount != 2 {
+		t.Errorf("expected DocCount = %d; got: %d", 2, agg.Buckets[0].DocCount)
+	}
+	if agg.Buckets[1].Key != "average" {
+		t.Errorf("expected Key = %q; got: %q", "average", agg.Buckets[1].Key)
+	}
+	if agg.Buckets[1].DocCount != 4 {
+		t.Errorf("expected DocCount = %d; got: %d", 4, agg.Buckets[1].DocCount)
+	}
+	if agg.Buckets[2].Key != "expensive" {
+		t.Errorf("expected Key = %q; got: %q", "expensive", agg.Buckets[2].Key)
+	}
+	if agg.Buckets[2].To != nil {
+		t.Errorf("expected To = nil; got: %v", *agg.Buckets[2].To)
+	}
+	if agg.Buckets[2].DocCount != 1 {
+		t.Errorf("expected DocCount = %d; got: %d", 1, agg.Buckets[2].DocCount)
+	}
+}
+
 func TestAggsDateRange(t *testing.T) {
 	s := `{
 	"range": {
@@ -2585,3 +2870,81 @@ func TestAggsRawMessage(t *testing.T) {
 	}
 }
 */
+
+func TestAggsScriptedMetric(t *testing.T) {
+	s := `{
+	"profit": {
+		"value": 170
+	},
+	"by_user": {
+		"value": {"olivere": 2, "sandrae": 1}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.ScriptedMetric("profit")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg == nil {
+		t.Fatalf("expected aggregation != nil; got: %v", agg)
+	}
+	if agg.Value != float64(170) {
+		t.Fatalf("expected aggregation value = %v; got: %v", float64(170), agg.Value)
+	}
+
+	agg, found = aggs.ScriptedMetric("by_user")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	byUser, ok := agg.Value.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected aggregation value to be a map; got: %T", agg.Value)
+	}
+	if byUser["olivere"] != float64(2) {
+		t.Errorf("expected %v; got: %v", float64(2), byUser["olivere"])
+	}
+}
+
+func TestAggsGlobalWithTermsBuckets(t *testing.T) {
+	s := `{
+	"all_products" : {
+		"doc_count" : 100,
+		"all_categories" : {
+			"buckets" : [
+				{ "key" : "books", "doc_count" : 60 },
+				{ "key" : "music", "doc_count" : 40 }
+			]
+		}
+	}
+}`
+
+	aggs := new(Aggregations)
+	err := json.Unmarshal([]byte(s), &aggs)
+	if err != nil {
+		t.Fatalf("expected no error decoding; got: %v", err)
+	}
+
+	agg, found := aggs.Global("all_products")
+	if !found {
+		t.Fatalf("expected aggregation to be found; got: %v", found)
+	}
+	if agg.DocCount != 100 {
+		t.Fatalf("expected aggregation DocCount = %d; got: %d", 100, agg.DocCount)
+	}
+	terms, found := agg.Terms("all_categories")
+	if !found {
+		t.Fatalf("expected sub aggregation to be found; got: %v", found)
+	}
+	if len(terms.Buckets) != 2 {
+		t.Fatalf("expected %d buckets; got: %d", 2, len(terms.Buckets))
+	}
+	if terms.Buckets[0].Key != "books" || terms.Buckets[0].DocCount != 60 {
+		t.Errorf("expected bucket books with 60 docs; got: %v with %d docs", terms.Buckets[0].Key, terms.Buckets[0].DocCount)
+	}
+}
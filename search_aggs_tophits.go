@@ -18,6 +18,7 @@ type TopHitsAggregation struct {
 	searchSource *SearchSource
 }
 
+// NewTopHitsAggregation creates a new TopHitsAggregation.
 func NewTopHitsAggregation() TopHitsAggregation {
 	a := TopHitsAggregation{
 		searchSource: NewSearchSource(),
@@ -25,11 +26,14 @@ func NewTopHitsAggregation() TopHitsAggregation {
 	return a
 }
 
+// From sets the offset of the first hit to return in each bucket.
 func (a TopHitsAggregation) From(from int) TopHitsAggregation {
 	a.searchSource = a.searchSource.From(from)
 	return a
 }
 
+// Size sets the maximum number of hits to return in each bucket.
+// Elasticsearch returns the top 3 hits by default.
 func (a TopHitsAggregation) Size(size int) TopHitsAggregation {
 	a.searchSource = a.searchSource.Size(size)
 	return a
@@ -55,11 +59,13 @@ func (a TopHitsAggregation) NoFields() TopHitsAggregation {
 	return a
 }
 
+// FetchSource indicates whether to return the _source of the hits.
 func (a TopHitsAggregation) FetchSource(fetchSource bool) TopHitsAggregation {
 	a.searchSource = a.searchSource.FetchSource(fetchSource)
 	return a
 }
 
+// FetchSourceContext specifies which parts of the _source to return.
 func (a TopHitsAggregation) FetchSourceContext(fetchSourceContext *FetchSourceContext) TopHitsAggregation {
 	a.searchSource = a.searchSource.FetchSourceContext(fetchSourceContext)
 	return a
@@ -95,6 +101,8 @@ func (a TopHitsAggregation) PartialField(partialField *PartialField) TopHitsAggr
 	return a
 }
 
+// Sort adds a sort order on field. By default, the hits are sorted
+// by score.
 func (a TopHitsAggregation) Sort(field string, ascending bool) TopHitsAggregation {
 	a.searchSource = a.searchSource.Sort(field, ascending)
 	return a
@@ -105,6 +113,7 @@ func (a TopHitsAggregation) SortWithInfo(info SortInfo) TopHitsAggregation {
 	return a
 }
 
+// SortBy adds one or more sort orders.
 func (a TopHitsAggregation) SortBy(sorter ...Sorter) TopHitsAggregation {
 	a.searchSource = a.searchSource.SortBy(sorter...)
 	return a
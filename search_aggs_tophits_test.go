@@ -20,7 +20,24 @@ func TestTopHitsAggregation(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"top_hits":{"_source":{"excludes":[],"includes":["title"]},"size":1,"sort":[{"last_activity_date":{"order":"desc"}}]}}`
+	expected := `{"top_hits":{"_source":["title"],"size":1,"sort":[{"last_activity_date":{"order":"desc"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestTopHitsAggregationWithFromAndNoSource(t *testing.T) {
+	agg := NewTopHitsAggregation().
+		Sort("price", true).
+		FetchSource(false).
+		From(3).
+		Size(3)
+	data, err := json.Marshal(agg.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"top_hits":{"_source":false,"from":3,"size":3,"sort":[{"price":{"order":"asc"}}]}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -25,21 +25,25 @@ func NewAndFilter(filters ...Filter) AndFilter {
 	return f
 }
 
-func (f AndFilter) Add(filter Filter) AndFilter {
-	f.filters = append(f.filters, filter)
+// Add adds one or more filters to the and filter.
+func (f AndFilter) Add(filters ...Filter) AndFilter {
+	f.filters = append(f.filters, filters...)
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f AndFilter) Cache(cache bool) AndFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f AndFilter) CacheKey(cacheKey string) AndFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f AndFilter) FilterName(filterName string) AndFilter {
 	f.filterName = filterName
 	return f
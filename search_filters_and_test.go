@@ -56,3 +56,16 @@ func TestNewAndFilter2(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestAndFilterAddMany(t *testing.T) {
+	f := NewAndFilter().Add(NewTermFilter("user", "olivere"), NewMissingFilter("retweets"))
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"and":{"filters":[{"term":{"user":"olivere"}},{"missing":{"field":"retweets"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -29,37 +29,43 @@ func NewBoolFilter() BoolFilter {
 	return f
 }
 
+// Must adds filters that must match.
 func (f BoolFilter) Must(filters ...Filter) BoolFilter {
 	f.mustClauses = append(f.mustClauses, filters...)
 	return f
 }
 
+// MustNot adds filters that must not match.
 func (f BoolFilter) MustNot(filters ...Filter) BoolFilter {
 	f.mustNotClauses = append(f.mustNotClauses, filters...)
 	return f
 }
 
+// Should adds filters of which at least one should match.
 func (f BoolFilter) Should(filters ...Filter) BoolFilter {
 	f.shouldClauses = append(f.shouldClauses, filters...)
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f BoolFilter) FilterName(filterName string) BoolFilter {
 	f.filterName = filterName
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f BoolFilter) Cache(cache bool) BoolFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f BoolFilter) CacheKey(cacheKey string) BoolFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
-// Creates the query source for the bool query.
+// Source returns the JSON-serializable bool filter.
 func (f BoolFilter) Source() interface{} {
 	// {
 	//	"bool" : {
@@ -0,0 +1,124 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// GeoBoundingBoxFilter filters documents with a geo point
+// that falls into a bounding box.
+//
+// For more details, see:
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-geo-bounding-box-filter.html
+type GeoBoundingBoxFilter struct {
+	Filter
+	name        string
+	topLeft     *GeoPoint
+	bottomRight *GeoPoint
+	typ         string
+	cache       *bool
+	cacheKey    string
+	filterName  string
+}
+
+// NewGeoBoundingBoxFilter creates a new geo_bounding_box filter
+// for the given field.
+func NewGeoBoundingBoxFilter(name string) GeoBoundingBoxFilter {
+	f := GeoBoundingBoxFilter{name: name}
+	return f
+}
+
+// TopLeft sets the top left corner of the bounding box.
+func (f GeoBoundingBoxFilter) TopLeft(lat, lon float64) GeoBoundingBoxFilter {
+	f.topLeft = GeoPointFromLatLon(lat, lon)
+	return f
+}
+
+// TopLeftFromGeoPoint sets the top left corner of the bounding box.
+func (f GeoBoundingBoxFilter) TopLeftFromGeoPoint(point *GeoPoint) GeoBoundingBoxFilter {
+	f.topLeft = point
+	return f
+}
+
+// BottomRight sets the bottom right corner of the bounding box.
+func (f GeoBoundingBoxFilter) BottomRight(lat, lon float64) GeoBoundingBoxFilter {
+	f.bottomRight = GeoPointFromLatLon(lat, lon)
+	return f
+}
+
+// BottomRightFromGeoPoint sets the bottom right corner of the bounding box.
+func (f GeoBoundingBoxFilter) BottomRightFromGeoPoint(point *GeoPoint) GeoBoundingBoxFilter {
+	f.bottomRight = point
+	return f
+}
+
+// Type specifies how the filter is executed,
+// i.e. "memory" (the default) or "indexed".
+func (f GeoBoundingBoxFilter) Type(typ string) GeoBoundingBoxFilter {
+	f.typ = typ
+	return f
+}
+
+// Cache specifies whether the filter result should be cached.
+func (f GeoBoundingBoxFilter) Cache(cache bool) GeoBoundingBoxFilter {
+	f.cache = &cache
+	return f
+}
+
+// CacheKey sets the key used to cache the filter result.
+func (f GeoBoundingBoxFilter) CacheKey(cacheKey string) GeoBoundingBoxFilter {
+	f.cacheKey = cacheKey
+	return f
+}
+
+// FilterName sets the name of the filter, reported in matched_filters.
+func (f GeoBoundingBoxFilter) FilterName(filterName string) GeoBoundingBoxFilter {
+	f.filterName = filterName
+	return f
+}
+
+// Source returns the filter source for the geo_bounding_box filter.
+func (f GeoBoundingBoxFilter) Source() interface{} {
+	// {
+	//   "geo_bounding_box" : {
+	//       "pin.location" : {
+	//           "top_left" : {
+	//               "lat" : 40.73,
+	//               "lon" : -74.1
+	//           },
+	//           "bottom_right" : {
+	//               "lat" : 40.01,
+	//               "lon" : -71.12
+	//           }
+	//       }
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+
+	params := make(map[string]interface{})
+	source["geo_bounding_box"] = params
+
+	box := make(map[string]interface{})
+	if f.topLeft != nil {
+		box["top_left"] = f.topLeft.Source()
+	}
+	if f.bottomRight != nil {
+		box["bottom_right"] = f.bottomRight.Source()
+	}
+	params[f.name] = box
+
+	if f.typ != "" {
+		params["type"] = f.typ
+	}
+	if f.cache != nil {
+		params["_cache"] = *f.cache
+	}
+	if f.cacheKey != "" {
+		params["_cache_key"] = f.cacheKey
+	}
+	if f.filterName != "" {
+		params["_name"] = f.filterName
+	}
+
+	return source
+}
@@ -0,0 +1,41 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeoBoundingBoxFilter(t *testing.T) {
+	f := NewGeoBoundingBoxFilter("pin.location").TopLeft(40.73, -74.1).BottomRight(40.01, -71.12)
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_bounding_box":{"pin.location":{"bottom_right":{"lat":40.01,"lon":-71.12},"top_left":{"lat":40.73,"lon":-74.1}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestGeoBoundingBoxFilterWithGeoPointsAndCache(t *testing.T) {
+	f := NewGeoBoundingBoxFilter("pin.location").
+		TopLeftFromGeoPoint(GeoPointFromLatLon(40.73, -74.1)).
+		BottomRightFromGeoPoint(GeoPointFromLatLon(40.01, -71.12)).
+		Type("indexed").
+		Cache(true).
+		FilterName("box")
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_bounding_box":{"_cache":true,"_name":"box","pin.location":{"bottom_right":{"lat":40.01,"lon":-71.12},"top_left":{"lat":40.73,"lon":-74.1}},"type":"indexed"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
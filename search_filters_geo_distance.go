@@ -29,58 +29,70 @@ func NewGeoDistanceFilter(name string) GeoDistanceFilter {
 	return f
 }
 
+// Distance sets the radius of the circle around the point, e.g. "5km".
 func (f GeoDistanceFilter) Distance(distance string) GeoDistanceFilter {
 	f.distance = distance
 	return f
 }
 
+// GeoPoint sets the center of the circle.
 func (f GeoDistanceFilter) GeoPoint(point *GeoPoint) GeoDistanceFilter {
 	f.lat = point.Lat
 	f.lon = point.Lon
 	return f
 }
 
+// Point sets the center of the circle.
 func (f GeoDistanceFilter) Point(lat, lon float64) GeoDistanceFilter {
 	f.lat = lat
 	f.lon = lon
 	return f
 }
 
+// Lat sets the latitude of the center of the circle.
 func (f GeoDistanceFilter) Lat(lat float64) GeoDistanceFilter {
 	f.lat = lat
 	return f
 }
 
+// Lon sets the longitude of the center of the circle.
 func (f GeoDistanceFilter) Lon(lon float64) GeoDistanceFilter {
 	f.lon = lon
 	return f
 }
 
+// GeoHash sets the center of the circle as a geohash.
 func (f GeoDistanceFilter) GeoHash(geohash string) GeoDistanceFilter {
 	f.geohash = geohash
 	return f
 }
 
+// DistanceType sets how the distance is computed, i.e. "arc" or "plane".
 func (f GeoDistanceFilter) DistanceType(distanceType string) GeoDistanceFilter {
 	f.distanceType = distanceType
 	return f
 }
 
+// OptimizeBbox sets whether to check against a bounding box first,
+// i.e. "memory" (the default), "indexed" or "none".
 func (f GeoDistanceFilter) OptimizeBbox(optimizeBbox string) GeoDistanceFilter {
 	f.optimizeBbox = optimizeBbox
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f GeoDistanceFilter) Cache(cache bool) GeoDistanceFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f GeoDistanceFilter) CacheKey(cacheKey string) GeoDistanceFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f GeoDistanceFilter) FilterName(filterName string) GeoDistanceFilter {
 	f.filterName = filterName
 	return f
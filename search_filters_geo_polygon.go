@@ -16,31 +16,37 @@ type GeoPolygonFilter struct {
 	filterName string
 }
 
+// NewGeoPolygonFilter creates a new geo_polygon filter for the given field.
 func NewGeoPolygonFilter(name string) GeoPolygonFilter {
 	f := GeoPolygonFilter{name: name, points: make([]*GeoPoint, 0)}
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f GeoPolygonFilter) Cache(cache bool) GeoPolygonFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f GeoPolygonFilter) CacheKey(cacheKey string) GeoPolygonFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f GeoPolygonFilter) FilterName(filterName string) GeoPolygonFilter {
 	f.filterName = filterName
 	return f
 }
 
-func (f GeoPolygonFilter) AddPoint(point *GeoPoint) GeoPolygonFilter {
-	f.points = append(f.points, point)
+// AddPoint adds one or more points to the polygon.
+func (f GeoPolygonFilter) AddPoint(points ...*GeoPoint) GeoPolygonFilter {
+	f.points = append(f.points, points...)
 	return f
 }
 
+// Source returns the filter source for the geo_polygon filter.
 func (f GeoPolygonFilter) Source() interface{} {
 	// "geo_polygon" : {
 	//  	"person.location" : {
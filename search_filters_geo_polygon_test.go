@@ -28,3 +28,20 @@ func TestGeoPolygonFilter(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestGeoPolygonFilterWithManyPoints(t *testing.T) {
+	f := NewGeoPolygonFilter("person.location").AddPoint(
+		GeoPointFromLatLon(40, -70),
+		GeoPointFromLatLon(30, -80),
+		GeoPointFromLatLon(20, -90),
+	)
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_polygon":{"person.location":{"points":[{"lat":40,"lon":-70},{"lat":30,"lon":-80},{"lat":20,"lon":-90}]}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -21,16 +21,19 @@ func NewNotFilter(filter Filter) NotFilter {
 	}
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f NotFilter) Cache(cache bool) NotFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f NotFilter) CacheKey(cacheKey string) NotFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f NotFilter) FilterName(filterName string) NotFilter {
 	f.filterName = filterName
 	return f
@@ -25,21 +25,25 @@ func NewOrFilter(filters ...Filter) OrFilter {
 	return f
 }
 
-func (f OrFilter) Add(filter Filter) OrFilter {
-	f.filters = append(f.filters, filter)
+// Add adds one or more filters to the or filter.
+func (f OrFilter) Add(filters ...Filter) OrFilter {
+	f.filters = append(f.filters, filters...)
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f OrFilter) Cache(cache bool) OrFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f OrFilter) CacheKey(cacheKey string) OrFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f OrFilter) FilterName(filterName string) OrFilter {
 	f.filterName = filterName
 	return f
@@ -21,80 +21,96 @@ type RangeFilter struct {
 	execution    string
 }
 
+// NewRangeFilter creates a new range filter on the given field.
+// Both bounds are inclusive by default.
 func NewRangeFilter(name string) RangeFilter {
 	f := RangeFilter{name: name, includeLower: true, includeUpper: true}
 	return f
 }
 
+// TimeZone sets the time zone used to convert date values in the filter.
 func (f RangeFilter) TimeZone(timeZone string) RangeFilter {
 	f.timeZone = timeZone
 	return f
 }
 
+// From sets the lower bound of the range.
 func (f RangeFilter) From(from interface{}) RangeFilter {
 	f.from = &from
 	return f
 }
 
+// Gt sets the lower bound of the range, excluding the bound itself.
 func (f RangeFilter) Gt(from interface{}) RangeFilter {
 	f.from = &from
 	f.includeLower = false
 	return f
 }
 
+// Gte sets the lower bound of the range, including the bound itself.
 func (f RangeFilter) Gte(from interface{}) RangeFilter {
 	f.from = &from
 	f.includeLower = true
 	return f
 }
 
+// To sets the upper bound of the range.
 func (f RangeFilter) To(to interface{}) RangeFilter {
 	f.to = &to
 	return f
 }
 
+// Lt sets the upper bound of the range, excluding the bound itself.
 func (f RangeFilter) Lt(to interface{}) RangeFilter {
 	f.to = &to
 	f.includeUpper = false
 	return f
 }
 
+// Lte sets the upper bound of the range, including the bound itself.
 func (f RangeFilter) Lte(to interface{}) RangeFilter {
 	f.to = &to
 	f.includeUpper = true
 	return f
 }
 
+// IncludeLower indicates whether the lower bound is part of the range.
 func (f RangeFilter) IncludeLower(includeLower bool) RangeFilter {
 	f.includeLower = includeLower
 	return f
 }
 
+// IncludeUpper indicates whether the upper bound is part of the range.
 func (f RangeFilter) IncludeUpper(includeUpper bool) RangeFilter {
 	f.includeUpper = includeUpper
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f RangeFilter) Cache(cache bool) RangeFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f RangeFilter) CacheKey(cacheKey string) RangeFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f RangeFilter) FilterName(filterName string) RangeFilter {
 	f.filterName = filterName
 	return f
 }
 
+// Execution sets the execution mode of the filter, i.e. "index" or "fielddata".
 func (f RangeFilter) Execution(execution string) RangeFilter {
 	f.execution = execution
 	return f
 }
 
+// Source returns the JSON-serializable range filter.
 func (f RangeFilter) Source() interface{} {
 	// {
 	//   "range" : {
@@ -56,3 +56,29 @@ func TestRangeFilterWithTimeZone(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestRangeFilterGtLt(t *testing.T) {
+	f := NewRangeFilter("age").Gt(10).Lt(20).Cache(true)
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"range":{"_cache":true,"age":{"from":10,"include_lower":false,"include_upper":false,"to":20}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestRangeFilterWithLowerBoundOnly(t *testing.T) {
+	f := NewRangeFilter("age").Gte(10)
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"range":{"age":{"from":10,"include_lower":true,"include_upper":true,"to":null}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -16,26 +16,32 @@ type TermFilter struct {
 	filterName string
 }
 
+// NewTermFilter creates a new term filter that matches documents
+// where the field contains the exact (not analyzed) value.
 func NewTermFilter(name string, value interface{}) TermFilter {
 	f := TermFilter{name: name, value: value}
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f TermFilter) Cache(cache bool) TermFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f TermFilter) CacheKey(cacheKey string) TermFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f TermFilter) FilterName(filterName string) TermFilter {
 	f.filterName = filterName
 	return f
 }
 
+// Source returns the JSON-serializable term filter.
 func (f TermFilter) Source() interface{} {
 	// {
 	//   "term" : {
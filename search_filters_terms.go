@@ -17,6 +17,8 @@ type TermsFilter struct {
 	execution  string
 }
 
+// NewTermsFilter creates a new terms filter that matches documents
+// where the field contains any of the given values.
 func NewTermsFilter(name string, values ...interface{}) TermsFilter {
 	f := TermsFilter{
 		name:   name,
@@ -26,26 +28,33 @@ func NewTermsFilter(name string, values ...interface{}) TermsFilter {
 	return f
 }
 
+// Cache specifies whether the filter result should be cached.
 func (f TermsFilter) Cache(cache bool) TermsFilter {
 	f.cache = &cache
 	return f
 }
 
+// CacheKey sets the key used to cache the filter result.
 func (f TermsFilter) CacheKey(cacheKey string) TermsFilter {
 	f.cacheKey = cacheKey
 	return f
 }
 
+// FilterName sets the name of the filter, reported in matched_filters.
 func (f TermsFilter) FilterName(filterName string) TermsFilter {
 	f.filterName = filterName
 	return f
 }
 
+// Execution sets the way the terms filter is executed, e.g. "plain"
+// (default), "bool", "and" or "or". "bool", "and" and "or" build
+// a combination of term filters, one per value.
 func (f TermsFilter) Execution(execution string) TermsFilter {
 	f.execution = execution
 	return f
 }
 
+// Source returns the JSON-serializable terms filter.
 func (f TermsFilter) Source() interface{} {
 	// {
 	//   "terms" : {
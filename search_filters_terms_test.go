@@ -25,3 +25,16 @@ func TestTermsFilter(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTermsFilterWithExecution(t *testing.T) {
+	f := NewTermsFilter("user", "olivere", "sandrae").Execution("bool").Cache(false)
+	data, err := json.Marshal(f.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"_cache":false,"execution":"bool","user":["olivere","sandrae"]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
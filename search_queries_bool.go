@@ -20,7 +20,7 @@ type BoolQuery struct {
 	queryName          string
 }
 
-// Creates a new bool query.
+// NewBoolQuery creates a new bool query.
 func NewBoolQuery() BoolQuery {
 	q := BoolQuery{
 		mustClauses:    make([]Query, 0),
@@ -30,47 +30,60 @@ func NewBoolQuery() BoolQuery {
 	return q
 }
 
+// Must adds queries that must appear in matching documents.
 func (q BoolQuery) Must(queries ...Query) BoolQuery {
 	q.mustClauses = append(q.mustClauses, queries...)
 	return q
 }
 
+// MustNot adds queries that must not appear in matching documents.
 func (q BoolQuery) MustNot(queries ...Query) BoolQuery {
 	q.mustNotClauses = append(q.mustNotClauses, queries...)
 	return q
 }
 
+// Should adds queries that should appear in matching documents.
+// Use MinimumShouldMatch to control how many of them must match.
 func (q BoolQuery) Should(queries ...Query) BoolQuery {
 	q.shouldClauses = append(q.shouldClauses, queries...)
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q BoolQuery) Boost(boost float32) BoolQuery {
 	q.boost = &boost
 	return q
 }
 
+// DisableCoord disables the coord factor in scoring.
 func (q BoolQuery) DisableCoord(disableCoord bool) BoolQuery {
 	q.disableCoord = &disableCoord
 	return q
 }
 
+// MinimumShouldMatch specifies the number or percentage of should
+// clauses that must match, e.g. "2" or "75%".
 func (q BoolQuery) MinimumShouldMatch(minimumShouldMatch string) BoolQuery {
 	q.minimumShouldMatch = minimumShouldMatch
 	return q
 }
 
+// AdjustPureNegative specifies whether a match_all query should be added
+// when the bool query only contains must_not clauses.
 func (q BoolQuery) AdjustPureNegative(adjustPureNegative bool) BoolQuery {
 	q.adjustPureNegative = &adjustPureNegative
 	return q
 }
 
+// QueryName sets the query name for the bool query that can be used
+// when searching for matched_filters per hit.
 func (q BoolQuery) QueryName(queryName string) BoolQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the bool query.
+// Source returns the query source for the bool query. Clauses without
+// any queries are omitted.
 func (q BoolQuery) Source() interface{} {
 	// {
 	//	"bool" : {
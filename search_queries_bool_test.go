@@ -27,3 +27,31 @@ func TestBoolQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestBoolQueryWithoutClauses(t *testing.T) {
+	q := NewBoolQuery()
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bool":{}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestBoolQueryWithMinimumShouldMatch(t *testing.T) {
+	q := NewBoolQuery()
+	q = q.Should(NewTermQuery("tag", "wow"), NewTermQuery("tag", "elasticsearch"), NewTermQuery("tag", "golang"))
+	q = q.MinimumShouldMatch("2")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"bool":{"minimum_should_match":"2","should":[{"term":{"tag":"wow"}},{"term":{"tag":"elasticsearch"}},{"term":{"tag":"golang"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -4,8 +4,13 @@
 
 package elastic
 
+import "fmt"
+
 // A boosting query can be used to effectively
-// demote results that match a given query.
+// demote results that match a given query. Documents matching the
+// positive query are returned, and those that also match the negative
+// query get their score multiplied by the negative boost, e.g. to
+// rank offers mentioning "used" below new items.
 // For more details, see:
 // http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-boosting-query.html
 type BoostingQuery struct {
@@ -16,32 +21,58 @@ type BoostingQuery struct {
 	boost          *float64
 }
 
-// Creates a new boosting query.
+// NewBoostingQuery creates a new boosting query.
 func NewBoostingQuery() BoostingQuery {
 	return BoostingQuery{}
 }
 
+// Positive sets the query that documents must match.
 func (q BoostingQuery) Positive(positive Query) BoostingQuery {
 	q.positiveClause = positive
 	return q
 }
 
+// Negative sets the query for documents that should be demoted.
 func (q BoostingQuery) Negative(negative Query) BoostingQuery {
 	q.negativeClause = negative
 	return q
 }
 
+// NegativeBoost sets the factor, typically between 0 and 1, that the
+// score of documents matching the negative query is multiplied with.
 func (q BoostingQuery) NegativeBoost(negativeBoost float64) BoostingQuery {
 	q.negativeBoost = &negativeBoost
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q BoostingQuery) Boost(boost float64) BoostingQuery {
 	q.boost = &boost
 	return q
 }
 
-// Creates the query source for the boosting query.
+// Validate checks if the query is valid, i.e. if the positive and
+// negative queries as well as the negative boost are set.
+func (q BoostingQuery) Validate() error {
+	var invalid []string
+	if q.positiveClause == nil {
+		invalid = append(invalid, "Positive")
+	}
+	if q.negativeClause == nil {
+		invalid = append(invalid, "Negative")
+	}
+	if q.negativeBoost == nil {
+		invalid = append(invalid, "NegativeBoost")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the query source for the boosting query.
+// If Validate fails, the returned source can't be serialized,
+// i.e. sending the query returns the error of Validate.
 func (q BoostingQuery) Source() interface{} {
 	// {
 	//     "boosting" : {
@@ -59,27 +90,20 @@ func (q BoostingQuery) Source() interface{} {
 	//     }
 	// }
 
+	if err := q.Validate(); err != nil {
+		return invalidQuery{err: fmt.Errorf("elastic: invalid boosting query: %v", err)}
+	}
+
 	query := make(map[string]interface{})
 
 	boostingClause := make(map[string]interface{})
 	query["boosting"] = boostingClause
 
 	// Negative and positive clause as well as negative boost
-	// are mandatory in the Java client.
-
-	// positive
-	if q.positiveClause != nil {
-		boostingClause["positive"] = q.positiveClause.Source()
-	}
-
-	// negative
-	if q.negativeClause != nil {
-		boostingClause["negative"] = q.negativeClause.Source()
-	}
-
-	if q.negativeBoost != nil {
-		boostingClause["negative_boost"] = *q.negativeBoost
-	}
+	// are mandatory, see Validate.
+	boostingClause["positive"] = q.positiveClause.Source()
+	boostingClause["negative"] = q.negativeClause.Source()
+	boostingClause["negative_boost"] = *q.negativeBoost
 
 	if q.boost != nil {
 		boostingClause["boost"] = *q.boost
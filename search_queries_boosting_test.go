@@ -24,3 +24,28 @@ func TestBoostingQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestBoostingQueryValidate(t *testing.T) {
+	positive := NewTermQuery("tag", "wow")
+	negative := NewTermQuery("tag", "used")
+	tests := []struct {
+		Query   BoostingQuery
+		Missing string
+	}{
+		{NewBoostingQuery().Negative(negative).NegativeBoost(0.2), "positive query"},
+		{NewBoostingQuery().Positive(positive).NegativeBoost(0.2), "negative query"},
+		{NewBoostingQuery().Positive(positive).Negative(negative), "negative boost"},
+	}
+	for _, test := range tests {
+		if err := test.Query.Validate(); err == nil {
+			t.Errorf("expected Validate to fail without %s", test.Missing)
+		}
+		if _, err := json.Marshal(test.Query.Source()); err == nil {
+			t.Errorf("expected serializing a query without %s to fail", test.Missing)
+		}
+	}
+	q := NewBoostingQuery().Positive(positive).Negative(negative).NegativeBoost(0.2)
+	if err := q.Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+}
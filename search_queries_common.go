@@ -7,30 +7,44 @@ package elastic
 // The common terms query is a modern alternative to stopwords
 // which improves the precision and recall of search results
 // (by taking stopwords into account), without sacrificing performance.
+// Terms more frequent than the cutoff frequency are only used for
+// scoring the documents that match the less frequent terms.
+//
+// Notice that the setters use a pointer receiver, so build the query
+// in a variable and pass its address to e.g. SearchService.Query:
+//
+//	q := elastic.NewCommonQuery("body", "nelly the elephant as a cartoon")
+//	q.CutoffFrequency(0.001).LowFreqOperator("and")
+//	res, err := client.Search().Query(&q).Do()
+//
 // For more details, see:
 // http://www.elasticsearch.org/guide/reference/query-dsl/common-terms-query/
 type CommonQuery struct {
 	Query
-	name             string
-	query            string
-	cutoffFreq       *float64
-	highFreq         *float64
-	highFreqOp       string
-	highFreqMinMatch interface{}
-	lowFreq          *float64
-	lowFreqOp        string
-	lowFreqMinMatch  interface{}
-	analyzer         string
-	boost            *float64
-	disableCoords    *bool
+	name               string
+	query              string
+	cutoffFreq         *float64
+	highFreq           *float64
+	highFreqOp         string
+	highFreqMinMatch   interface{}
+	lowFreq            *float64
+	lowFreqOp          string
+	lowFreqMinMatch    interface{}
+	minimumShouldMatch string
+	analyzer           string
+	boost              *float64
+	disableCoords      *bool
 }
 
-// Creates a new common query.
+// Creates a new common query for the given field and query text.
 func NewCommonQuery(name string, query string) CommonQuery {
 	q := CommonQuery{name: name, query: query}
 	return q
 }
 
+// CutoffFrequency sets the document frequency above which a term is
+// treated as a high frequency term, either as an absolute number (>= 1)
+// or as a fraction of the number of documents (< 1), e.g. 0.001.
 func (q *CommonQuery) CutoffFrequency(f float64) *CommonQuery {
 	q.cutoffFreq = &f
 	return q
@@ -41,11 +55,15 @@ func (q *CommonQuery) HighFreq(f float64) *CommonQuery {
 	return q
 }
 
+// HighFreqOperator sets the operator ("or" or "and") used to
+// combine the high frequency terms.
 func (q *CommonQuery) HighFreqOperator(op string) *CommonQuery {
 	q.highFreqOp = op
 	return q
 }
 
+// HighFreqMinMatch sets the minimum_should_match for the high
+// frequency terms, e.g. 3 or "30%".
 func (q *CommonQuery) HighFreqMinMatch(min interface{}) *CommonQuery {
 	q.highFreqMinMatch = min
 	return q
@@ -56,16 +74,29 @@ func (q *CommonQuery) LowFreq(f float64) *CommonQuery {
 	return q
 }
 
+// LowFreqOperator sets the operator ("or" or "and") used to
+// combine the low frequency terms.
 func (q *CommonQuery) LowFreqOperator(op string) *CommonQuery {
 	q.lowFreqOp = op
 	return q
 }
 
+// LowFreqMinMatch sets the minimum_should_match for the low
+// frequency terms, e.g. 2 or "60%".
 func (q *CommonQuery) LowFreqMinMatch(min interface{}) *CommonQuery {
 	q.lowFreqMinMatch = min
 	return q
 }
 
+// MinimumShouldMatch sets the minimum number of low frequency terms
+// that must match, e.g. "2" or "60%". Use LowFreqMinMatch and
+// HighFreqMinMatch to set it for low and high frequency terms
+// separately; they take precedence over MinimumShouldMatch.
+func (q *CommonQuery) MinimumShouldMatch(minimumShouldMatch string) *CommonQuery {
+	q.minimumShouldMatch = minimumShouldMatch
+	return q
+}
+
 func (q *CommonQuery) Analyzer(analyzer string) *CommonQuery {
 	q.analyzer = analyzer
 	return q
@@ -126,6 +157,8 @@ func (q CommonQuery) Source() interface{} {
 			mm["high_freq"] = q.highFreqMinMatch
 		}
 		query["minimum_should_match"] = mm
+	} else if q.minimumShouldMatch != "" {
+		query["minimum_should_match"] = q.minimumShouldMatch
 	}
 
 	if q.analyzer != "" {
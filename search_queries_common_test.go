@@ -65,3 +65,31 @@ func TestSearchQueriesCommon(t *testing.T) {
 		}
 	}
 }
+
+func TestCommonQuerySource(t *testing.T) {
+	q := NewCommonQuery("body", "nelly the elephant as a cartoon")
+	q.CutoffFrequency(0.001).LowFreqOperator("and").MinimumShouldMatch("2")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"common":{"body":{"cutoff_frequency":0.001,"low_freq_operator":"and","minimum_should_match":"2","query":"nelly the elephant as a cartoon"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestCommonQuerySourceWithLowAndHighFreqMinMatch(t *testing.T) {
+	q := NewCommonQuery("body", "nelly the elephant not as a cartoon")
+	q.CutoffFrequency(0.001).HighFreqOperator("or").LowFreqMinMatch(2).HighFreqMinMatch(3)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"common":{"body":{"cutoff_frequency":0.001,"high_freq_operator":"or","minimum_should_match":{"high_freq":3,"low_freq":2},"query":"nelly the elephant not as a cartoon"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// ConstantScoreQuery wraps a filter (or query) and returns every
+// matching document with a constant score that equals the boost.
+//
+// For more details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-constant-score-query.html
+type ConstantScoreQuery struct {
+	filter Filter
+	query  Query
+	boost  *float32
+}
+
+// NewConstantScoreQuery creates a new constant_score query
+// that wraps the given filter.
+func NewConstantScoreQuery(filter Filter) ConstantScoreQuery {
+	q := ConstantScoreQuery{filter: filter}
+	return q
+}
+
+// Filter sets the filter to wrap. It replaces a query set with Query.
+func (q ConstantScoreQuery) Filter(filter Filter) ConstantScoreQuery {
+	q.filter = filter
+	q.query = nil
+	return q
+}
+
+// Query sets the query to wrap. It replaces a filter set with Filter.
+func (q ConstantScoreQuery) Query(query Query) ConstantScoreQuery {
+	q.query = query
+	q.filter = nil
+	return q
+}
+
+// Boost sets the constant score of all matching documents.
+func (q ConstantScoreQuery) Boost(boost float32) ConstantScoreQuery {
+	q.boost = &boost
+	return q
+}
+
+// Source returns the query source for the constant_score query.
+func (q ConstantScoreQuery) Source() interface{} {
+	// {
+	//   "constant_score" : {
+	//     "filter" : {
+	//       "term" : { "user" : "kimchy"}
+	//     },
+	//     "boost" : 1.2
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+
+	query := make(map[string]interface{})
+	source["constant_score"] = query
+
+	if q.query != nil {
+		query["query"] = q.query.Source()
+	} else if q.filter != nil {
+		query["filter"] = q.filter.Source()
+	}
+	if q.boost != nil {
+		query["boost"] = *q.boost
+	}
+
+	return source
+}
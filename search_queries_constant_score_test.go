@@ -0,0 +1,36 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestConstantScoreQuery(t *testing.T) {
+	q := NewConstantScoreQuery(NewTermFilter("user", "kimchy")).Boost(1.2)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"constant_score":{"boost":1.2,"filter":{"term":{"user":"kimchy"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestConstantScoreQueryWithQuery(t *testing.T) {
+	q := NewConstantScoreQuery(nil).Query(NewTermQuery("user", "kimchy"))
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"constant_score":{"query":{"term":{"user":"kimchy"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
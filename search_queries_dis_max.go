@@ -17,7 +17,7 @@ type DisMaxQuery struct {
 	tieBreaker *float32
 }
 
-// Creates a new dis_max query.
+// NewDisMaxQuery creates a new dis_max query.
 func NewDisMaxQuery() DisMaxQuery {
 	q := DisMaxQuery{
 		queries: make([]Query, 0),
@@ -25,28 +25,32 @@ func NewDisMaxQuery() DisMaxQuery {
 	return q
 }
 
-func (q DisMaxQuery) Query(query Query) DisMaxQuery {
-	q.queries = append(q.queries, query)
+// Query adds one or more subqueries.
+func (q DisMaxQuery) Query(queries ...Query) DisMaxQuery {
+	q.queries = append(q.queries, queries...)
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q DisMaxQuery) Boost(boost float32) DisMaxQuery {
 	q.boost = &boost
 	return q
 }
 
+// TieBreaker sets the factor by which the scores of subqueries other
+// than the best matching one are multiplied and added to the score.
 func (q DisMaxQuery) TieBreaker(tieBreaker float32) DisMaxQuery {
 	q.tieBreaker = &tieBreaker
 	return q
 }
 
-// Creates the query source for the dis_max query.
+// Source returns the query source for the dis_max query.
 func (q DisMaxQuery) Source() interface{} {
 	// {
 	//  "dis_max" : {
 	//    "tie_breaker" : 0.7,
 	//    "boost" : 1.2,
-	//    "queries" : {
+	//    "queries" : [
 	//      {
 	//        "term" : { "age" : 34 }
 	//      },
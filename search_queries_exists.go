@@ -0,0 +1,48 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// ExistsQuery matches documents where a specific field has a value in them.
+// Notice that the exists query requires Elasticsearch 2.0 or later. With
+// earlier versions, use an ExistsFilter inside a ConstantScoreQuery or
+// FilteredQuery instead.
+// For details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-exists-query.html
+type ExistsQuery struct {
+	Query
+	name      string
+	queryName string
+}
+
+// NewExistsQuery creates a new exists query for the given field.
+func NewExistsQuery(name string) ExistsQuery {
+	q := ExistsQuery{name: name}
+	return q
+}
+
+// QueryName sets the query name for the exists query that can be used
+// when searching for matched_filters per hit.
+func (q ExistsQuery) QueryName(queryName string) ExistsQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the exists query.
+func (q ExistsQuery) Source() interface{} {
+	// {
+	//   "exists" : {
+	//     "field" : "..."
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["exists"] = params
+	params["field"] = q.name
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+	return source
+}
@@ -14,20 +14,28 @@ type FilteredQuery struct {
 	boost   *float32
 }
 
-// Creates a new filtered query.
-func NewFilteredQuery(query Query) FilteredQuery {
+// Creates a new filtered query that scores documents with the given
+// query and restricts them with the given filters, e.g.
+// NewFilteredQuery(NewMatchQuery("message", "golang"), NewTermFilter("user", "olivere")).
+// The query may be nil, in which case Elasticsearch filters all
+// documents, as if a match_all query was given.
+func NewFilteredQuery(query Query, filters ...Filter) FilteredQuery {
 	q := FilteredQuery{
 		query:   query,
 		filters: make([]Filter, 0),
 	}
+	q.filters = append(q.filters, filters...)
 	return q
 }
 
+// Filter adds a filter that is applied to the results of the query.
+// Multiple filters are combined with an and filter.
 func (q FilteredQuery) Filter(filter Filter) FilteredQuery {
 	q.filters = append(q.filters, filter)
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q FilteredQuery) Boost(boost float32) FilteredQuery {
 	q.boost = &boost
 	return q
@@ -53,7 +61,9 @@ func (q FilteredQuery) Source() interface{} {
 	filtered := make(map[string]interface{})
 	source["filtered"] = filtered
 
-	filtered["query"] = q.query.Source()
+	if q.query != nil {
+		filtered["query"] = q.query.Source()
+	}
 
 	if len(q.filters) == 1 {
 		filtered["filter"] = q.filters[0].Source()
@@ -67,15 +77,6 @@ func (q FilteredQuery) Source() interface{} {
 			filters = append(filters, f.Source())
 		}
 		and["filters"] = filters
-		/*
-			anded := make([]map[string]interface{}, 0)
-			filtered["filter"] = anded
-			for _, f := range q.filters {
-				andElem := make(map[string]interface{})
-				andElem["and"] = f.Source()
-				anded = append(anded, andElem)
-			}
-		*/
 	}
 
 	if q.boost != nil {
@@ -0,0 +1,83 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestFilteredQuery(t *testing.T) {
+	q := NewFilteredQuery(NewTermQuery("tag", "wow"))
+	q = q.Filter(NewTermFilter("user", "olivere"))
+	q = q.Boost(1.5)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filtered":{"boost":1.5,"filter":{"term":{"user":"olivere"}},"query":{"term":{"tag":"wow"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFilteredQueryWithManyFilters(t *testing.T) {
+	q := NewFilteredQuery(NewTermQuery("tag", "wow"))
+	q = q.Filter(NewTermFilter("user", "olivere"))
+	q = q.Filter(NewNotFilter(NewMissingFilter("retweets")))
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filtered":{"filter":{"and":{"filters":[{"term":{"user":"olivere"}},{"not":{"filter":{"missing":{"field":"retweets"}}}}]}},"query":{"term":{"tag":"wow"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFilteredQueryWithoutQuery(t *testing.T) {
+	q := NewFilteredQuery(nil).Filter(NewBoolFilter().Must(NewTermFilter("user", "olivere")))
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filtered":{"filter":{"bool":{"must":{"term":{"user":"olivere"}}}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFilteredQueryWithFiltersInConstructor(t *testing.T) {
+	q := NewFilteredQuery(NewMatchQuery("message", "golang"), NewTermFilter("user", "olivere"))
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"filtered":{"filter":{"term":{"user":"olivere"}},"query":{"match":{"message":"golang"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestFilteredQueryInScroll(t *testing.T) {
+	q := NewFilteredQuery(nil, NewRangeFilter("retweets").Gte(10))
+	body, err := NewScrollService(nil).Query(q).body()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"filtered":{"filter":{"range":{"retweets":{"from":10,"include_lower":true,"include_upper":true,"to":null}}}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
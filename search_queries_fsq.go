@@ -30,50 +30,63 @@ func NewFunctionScoreQuery() FunctionScoreQuery {
 	}
 }
 
+// Query sets the query whose results are scored by the functions.
 func (q FunctionScoreQuery) Query(query Query) FunctionScoreQuery {
 	q.query = query
 	q.filter = nil
 	return q
 }
 
+// Filter sets the filter whose results are scored by the functions.
 func (q FunctionScoreQuery) Filter(filter Filter) FunctionScoreQuery {
 	q.query = nil
 	q.filter = filter
 	return q
 }
 
+// Add adds a score function that is only applied to documents
+// matching the given filter.
 func (q FunctionScoreQuery) Add(filter Filter, scoreFunc ScoreFunction) FunctionScoreQuery {
 	q.filters = append(q.filters, filter)
 	q.scoreFuncs = append(q.scoreFuncs, scoreFunc)
 	return q
 }
 
+// AddScoreFunc adds a score function that is applied to all documents.
 func (q FunctionScoreQuery) AddScoreFunc(scoreFunc ScoreFunction) FunctionScoreQuery {
 	q.filters = append(q.filters, nil)
 	q.scoreFuncs = append(q.scoreFuncs, scoreFunc)
 	return q
 }
 
+// ScoreMode specifies how the scores of the functions are combined, i.e.
+// "multiply" (the default), "sum", "avg", "first", "max", or "min".
 func (q FunctionScoreQuery) ScoreMode(scoreMode string) FunctionScoreQuery {
 	q.scoreMode = scoreMode
 	return q
 }
 
+// BoostMode specifies how the combined score of the functions is combined
+// with the score of the query, i.e. "multiply" (the default), "replace",
+// "sum", "avg", "max", or "min".
 func (q FunctionScoreQuery) BoostMode(boostMode string) FunctionScoreQuery {
 	q.boostMode = boostMode
 	return q
 }
 
+// MaxBoost restricts the score of the functions to the given maximum.
 func (q FunctionScoreQuery) MaxBoost(maxBoost float32) FunctionScoreQuery {
 	q.maxBoost = &maxBoost
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q FunctionScoreQuery) Boost(boost float32) FunctionScoreQuery {
 	q.boost = &boost
 	return q
 }
 
+// MinScore excludes documents that do not meet the given score.
 func (q FunctionScoreQuery) MinScore(minScore float32) FunctionScoreQuery {
 	q.minScore = &minScore
 	return q
@@ -92,14 +105,22 @@ func (q FunctionScoreQuery) Source() interface{} {
 	}
 
 	if len(q.filters) == 1 && q.filters[0] == nil {
+		// Weight needs to be serialized on this level
+		if weight := q.scoreFuncs[0].GetWeight(); weight != nil {
+			query["weight"] = *weight
+		}
 		query[q.scoreFuncs[0].Name()] = q.scoreFuncs[0].Source()
-	} else {
+	} else if len(q.filters) > 0 {
 		funcs := make([]interface{}, len(q.filters))
 		for i, filter := range q.filters {
 			hsh := make(map[string]interface{})
 			if filter != nil {
 				hsh["filter"] = filter.Source()
 			}
+			// Weight needs to be serialized on this level
+			if weight := q.scoreFuncs[i].GetWeight(); weight != nil {
+				hsh["weight"] = *weight
+			}
 			hsh[q.scoreFuncs[i].Name()] = q.scoreFuncs[i].Source()
 			funcs[i] = hsh
 		}
@@ -11,6 +11,7 @@ import (
 // ScoreFunction is used in combination with the Function Score Query.
 type ScoreFunction interface {
 	Name() string
+	GetWeight() *float64 // returns the weight which must be serialized at the level of FunctionScoreQuery
 	Source() interface{}
 }
 
@@ -58,11 +59,18 @@ func (fn ExponentialDecayFunction) Offset(offset interface{}) ExponentialDecayFu
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn ExponentialDecayFunction) Weight(weight float64) ExponentialDecayFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn ExponentialDecayFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 func (fn ExponentialDecayFunction) Source() interface{} {
 	source := make(map[string]interface{})
 	params := make(map[string]interface{})
@@ -77,9 +85,6 @@ func (fn ExponentialDecayFunction) Source() interface{} {
 	if fn.offset != nil {
 		params["offset"] = fn.offset
 	}
-	if fn.weight != nil {
-		params["weight"] = *fn.weight
-	}
 	return source
 }
 
@@ -127,11 +132,18 @@ func (fn GaussDecayFunction) Offset(offset interface{}) GaussDecayFunction {
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn GaussDecayFunction) Weight(weight float64) GaussDecayFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn GaussDecayFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 func (fn GaussDecayFunction) Source() interface{} {
 	source := make(map[string]interface{})
 	params := make(map[string]interface{})
@@ -146,9 +158,6 @@ func (fn GaussDecayFunction) Source() interface{} {
 	if fn.offset != nil {
 		params["offset"] = fn.offset
 	}
-	if fn.weight != nil {
-		params["weight"] = *fn.weight
-	}
 	return source
 }
 
@@ -196,11 +205,18 @@ func (fn LinearDecayFunction) Offset(offset interface{}) LinearDecayFunction {
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn LinearDecayFunction) Weight(weight float64) LinearDecayFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn LinearDecayFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 func (fn LinearDecayFunction) Source() interface{} {
 	source := make(map[string]interface{})
 	params := make(map[string]interface{})
@@ -215,9 +231,6 @@ func (fn LinearDecayFunction) Source() interface{} {
 	if fn.offset != nil {
 		params["offset"] = fn.offset
 	}
-	if fn.weight != nil {
-		params["weight"] = *fn.weight
-	}
 	return source
 }
 
@@ -267,11 +280,18 @@ func (fn ScriptFunction) Params(params map[string]interface{}) ScriptFunction {
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn ScriptFunction) Weight(weight float64) ScriptFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn ScriptFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 func (fn ScriptFunction) Source() interface{} {
 	source := make(map[string]interface{})
 	if fn.script != "" {
@@ -286,9 +306,6 @@ func (fn ScriptFunction) Source() interface{} {
 	if len(fn.params) > 0 {
 		source["params"] = fn.params
 	}
-	if fn.weight != nil {
-		source["weight"] = *fn.weight
-	}
 	return source
 }
 
@@ -312,6 +329,11 @@ func (fn FactorFunction) BoostFactor(boost float32) FactorFunction {
 	return fn
 }
 
+// GetWeight always returns nil for (deprecated) FactorFunction.
+func (fn FactorFunction) GetWeight() *float64 {
+	return nil
+}
+
 func (fn FactorFunction) Source() interface{} {
 	return fn.boostFactor
 }
@@ -358,11 +380,18 @@ func (fn FieldValueFactorFunction) Modifier(modifier string) FieldValueFactorFun
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn FieldValueFactorFunction) Weight(weight float64) FieldValueFactorFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn FieldValueFactorFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 // Source returns the JSON to be serialized into the query.
 func (fn FieldValueFactorFunction) Source() interface{} {
 	source := make(map[string]interface{})
@@ -375,9 +404,6 @@ func (fn FieldValueFactorFunction) Source() interface{} {
 	if fn.modifier != "" {
 		source["modifier"] = strings.ToLower(fn.modifier)
 	}
-	if fn.weight != nil {
-		source["weight"] = *fn.weight
-	}
 	return source
 }
 
@@ -400,6 +426,12 @@ func (fn WeightFactorFunction) Weight(weight float64) WeightFactorFunction {
 	return fn
 }
 
+// GetWeight returns nil for WeightFactorFunction, as its weight
+// is already serialized as the function itself.
+func (fn WeightFactorFunction) GetWeight() *float64 {
+	return nil
+}
+
 func (fn WeightFactorFunction) Source() interface{} {
 	return fn.weight
 }
@@ -424,18 +456,22 @@ func (fn RandomFunction) Seed(seed int64) RandomFunction {
 	return fn
 }
 
+// Weight adjusts the score of the function by multiplying it with weight.
 func (fn RandomFunction) Weight(weight float64) RandomFunction {
 	fn.weight = &weight
 	return fn
 }
 
+// GetWeight returns the adjusted score. It is part of the ScoreFunction interface.
+// Returns nil if weight is not specified.
+func (fn RandomFunction) GetWeight() *float64 {
+	return fn.weight
+}
+
 func (fn RandomFunction) Source() interface{} {
 	source := make(map[string]interface{})
 	if fn.seed != nil {
 		source["seed"] = *fn.seed
 	}
-	if fn.weight != nil {
-		source["weight"] = *fn.weight
-	}
 	return source
 }
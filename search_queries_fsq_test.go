@@ -80,7 +80,7 @@ func TestFieldValueFactorWithWeight(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"function_score":{"boost":2,"boost_mode":"multiply","field_value_factor":{"factor":2,"field":"income","modifier":"sqrt","weight":2.5},"max_boost":12,"query":{"term":{"name.last":"banon"}},"score_mode":"max"}}`
+	expected := `{"function_score":{"boost":2,"boost_mode":"multiply","field_value_factor":{"factor":2,"field":"income","modifier":"sqrt"},"max_boost":12,"query":{"term":{"name.last":"banon"}},"score_mode":"max","weight":2.5}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -100,3 +100,24 @@ func TestFunctionScoreQueryWithGaussScoreFunc(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFunctionScoreQueryWithMultipleFunctionsAndWeights(t *testing.T) {
+	q := NewFunctionScoreQuery().
+		Query(NewMatchQuery("message", "golang")).
+		Add(NewTermFilter("user", "olivere"), NewGaussDecayFunction().FieldName("created").Origin("now").Scale("10d").Weight(2)).
+		AddScoreFunc(NewFieldValueFactorFunction().Field("retweets").Modifier("log1p")).
+		AddScoreFunc(NewRandomFunction().Seed(42).Weight(0.5)).
+		AddScoreFunc(NewScriptFunction("_score * doc['retweets'].value").Lang("groovy")).
+		ScoreMode("sum").
+		BoostMode("multiply").
+		MaxBoost(42)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"function_score":{"boost_mode":"multiply","functions":[{"filter":{"term":{"user":"olivere"}},"gauss":{"created":{"origin":"now","scale":"10d"}},"weight":2},{"field_value_factor":{"field":"retweets","modifier":"log1p"}},{"random_score":{"seed":42},"weight":0.5},{"script_score":{"lang":"groovy","script":"_score * doc['retweets'].value"}}],"max_boost":42,"query":{"match":{"message":"golang"}},"score_mode":"sum"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -21,57 +21,68 @@ type FuzzyQuery struct {
 	queryName      string
 }
 
-// NewFuzzyQuery creates a new fuzzy query.
-func NewFuzzyQuery() FuzzyQuery {
+// NewFuzzyQuery creates a new fuzzy query for the given field and value.
+func NewFuzzyQuery(name string, value interface{}) FuzzyQuery {
 	q := FuzzyQuery{
+		name:  name,
+		value: value,
 		boost: -1.0,
 	}
 	return q
 }
 
+// Name sets the field to run the query against.
 func (q FuzzyQuery) Name(name string) FuzzyQuery {
 	q.name = name
 	return q
 }
 
+// Value sets the term to search for.
 func (q FuzzyQuery) Value(value interface{}) FuzzyQuery {
 	q.value = value
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q FuzzyQuery) Boost(boost float32) FuzzyQuery {
 	q.boost = boost
 	return q
 }
 
-// Fuzziness can be an integer/long like 0, 1 or 2 as well as strings like "auto",
-// "0..1", "1..4" or "0.0..1.0".
+// Fuzziness sets the maximum edit distance. It can be an integer
+// like 2 or a string like "AUTO".
 func (q FuzzyQuery) Fuzziness(fuzziness interface{}) FuzzyQuery {
 	q.fuzziness = fuzziness
 	return q
 }
 
+// PrefixLength sets the number of initial characters that
+// must match exactly.
 func (q FuzzyQuery) PrefixLength(prefixLength int) FuzzyQuery {
 	q.prefixLength = &prefixLength
 	return q
 }
 
+// MaxExpansions sets the maximum number of terms the query expands to.
 func (q FuzzyQuery) MaxExpansions(maxExpansions int) FuzzyQuery {
 	q.maxExpansions = &maxExpansions
 	return q
 }
 
+// Transpositions indicates whether transpositions count as one edit.
 func (q FuzzyQuery) Transpositions(transpositions bool) FuzzyQuery {
 	q.transpositions = &transpositions
 	return q
 }
 
+// QueryName sets the query name for the fuzzy query that can be used
+// when searching for matched_filters per hit.
 func (q FuzzyQuery) QueryName(queryName string) FuzzyQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the ids query.
+// Source returns the query source for the fuzzy query.
 func (q FuzzyQuery) Source() interface{} {
 	// {
 	//	"fuzzy" : {
@@ -10,7 +10,7 @@ import (
 )
 
 func TestFuzzyQuery(t *testing.T) {
-	q := NewFuzzyQuery().Name("user").Value("ki").Boost(1.5).Fuzziness(2).PrefixLength(0).MaxExpansions(100)
+	q := NewFuzzyQuery("user", "ki").Boost(1.5).Fuzziness(2).PrefixLength(0).MaxExpansions(100)
 	data, err := json.Marshal(q.Source())
 	if err != nil {
 		t.Fatalf("marshaling to JSON failed: %v", err)
@@ -21,3 +21,16 @@ func TestFuzzyQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestFuzzyQueryWithAutoFuzziness(t *testing.T) {
+	q := NewFuzzyQuery("user", "kimchy").Fuzziness("AUTO")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"fuzzy":{"user":{"fuzziness":"AUTO","value":"kimchy"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// GeoBoundingBoxQuery matches documents with a geo point
+// that falls into a bounding box.
+// Notice that the geo_bounding_box query requires Elasticsearch 2.0 or later.
+//
+// For more details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-bounding-box-query.html
+type GeoBoundingBoxQuery struct {
+	Query
+	name        string
+	topLeft     *GeoPoint
+	bottomRight *GeoPoint
+	typ         string
+	queryName   string
+}
+
+// NewGeoBoundingBoxQuery creates a new geo_bounding_box query
+// for the given field.
+func NewGeoBoundingBoxQuery(name string) GeoBoundingBoxQuery {
+	q := GeoBoundingBoxQuery{name: name}
+	return q
+}
+
+// TopLeft sets the top left corner of the bounding box.
+func (q GeoBoundingBoxQuery) TopLeft(lat, lon float64) GeoBoundingBoxQuery {
+	q.topLeft = GeoPointFromLatLon(lat, lon)
+	return q
+}
+
+// TopLeftFromGeoPoint sets the top left corner of the bounding box.
+func (q GeoBoundingBoxQuery) TopLeftFromGeoPoint(point *GeoPoint) GeoBoundingBoxQuery {
+	q.topLeft = point
+	return q
+}
+
+// BottomRight sets the bottom right corner of the bounding box.
+func (q GeoBoundingBoxQuery) BottomRight(lat, lon float64) GeoBoundingBoxQuery {
+	q.bottomRight = GeoPointFromLatLon(lat, lon)
+	return q
+}
+
+// BottomRightFromGeoPoint sets the bottom right corner of the bounding box.
+func (q GeoBoundingBoxQuery) BottomRightFromGeoPoint(point *GeoPoint) GeoBoundingBoxQuery {
+	q.bottomRight = point
+	return q
+}
+
+// Type specifies how the query is executed,
+// i.e. "memory" (the default) or "indexed".
+func (q GeoBoundingBoxQuery) Type(typ string) GeoBoundingBoxQuery {
+	q.typ = typ
+	return q
+}
+
+// QueryName sets the query name for the geo_bounding_box query that can be used
+// when searching for matched_filters per hit.
+func (q GeoBoundingBoxQuery) QueryName(queryName string) GeoBoundingBoxQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the geo_bounding_box query.
+func (q GeoBoundingBoxQuery) Source() interface{} {
+	// {
+	//   "geo_bounding_box" : {
+	//       "pin.location" : {
+	//           "top_left" : {
+	//               "lat" : 40.73,
+	//               "lon" : -74.1
+	//           },
+	//           "bottom_right" : {
+	//               "lat" : 40.01,
+	//               "lon" : -71.12
+	//           }
+	//       }
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+
+	params := make(map[string]interface{})
+	source["geo_bounding_box"] = params
+
+	box := make(map[string]interface{})
+	if q.topLeft != nil {
+		box["top_left"] = q.topLeft.Source()
+	}
+	if q.bottomRight != nil {
+		box["bottom_right"] = q.bottomRight.Source()
+	}
+	params[q.name] = box
+
+	if q.typ != "" {
+		params["type"] = q.typ
+	}
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+
+	return source
+}
@@ -0,0 +1,136 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// GeoDistanceQuery matches documents that include only hits that exist
+// within a specific distance from a geo point.
+// Notice that the geo_distance query requires Elasticsearch 2.0 or later.
+// With earlier versions, use a GeoDistanceFilter instead.
+//
+// For more details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-distance-query.html
+type GeoDistanceQuery struct {
+	Query
+	name         string
+	distance     string
+	lat          float64
+	lon          float64
+	geohash      string
+	distanceType string
+	optimizeBbox string
+	queryName    string
+}
+
+// NewGeoDistanceQuery creates a new geo_distance query for the given field.
+func NewGeoDistanceQuery(name string) GeoDistanceQuery {
+	q := GeoDistanceQuery{name: name}
+	return q
+}
+
+// Distance sets the radius of the circle around the point,
+// e.g. "200km" or "12mi".
+func (q GeoDistanceQuery) Distance(distance string) GeoDistanceQuery {
+	q.distance = distance
+	return q
+}
+
+// GeoPoint sets the center of the circle. A nil point leaves
+// the center unchanged.
+func (q GeoDistanceQuery) GeoPoint(point *GeoPoint) GeoDistanceQuery {
+	if point != nil {
+		q.lat = point.Lat
+		q.lon = point.Lon
+	}
+	return q
+}
+
+// Point sets the center of the circle by latitude and longitude.
+func (q GeoDistanceQuery) Point(lat, lon float64) GeoDistanceQuery {
+	q.lat = lat
+	q.lon = lon
+	return q
+}
+
+// Lat sets the latitude of the center of the circle.
+func (q GeoDistanceQuery) Lat(lat float64) GeoDistanceQuery {
+	q.lat = lat
+	return q
+}
+
+// Lon sets the longitude of the center of the circle.
+func (q GeoDistanceQuery) Lon(lon float64) GeoDistanceQuery {
+	q.lon = lon
+	return q
+}
+
+// GeoHash sets the center of the circle as a geohash.
+func (q GeoDistanceQuery) GeoHash(geohash string) GeoDistanceQuery {
+	q.geohash = geohash
+	return q
+}
+
+// DistanceType specifies how to compute the distance,
+// i.e. "sloppy_arc" (the default), "arc", or "plane".
+func (q GeoDistanceQuery) DistanceType(distanceType string) GeoDistanceQuery {
+	q.distanceType = distanceType
+	return q
+}
+
+// OptimizeBbox specifies whether to use a bounding box check first,
+// i.e. "memory" (the default), "indexed", or "none".
+func (q GeoDistanceQuery) OptimizeBbox(optimizeBbox string) GeoDistanceQuery {
+	q.optimizeBbox = optimizeBbox
+	return q
+}
+
+// QueryName sets the query name for the geo_distance query that can be used
+// when searching for matched_filters per hit.
+func (q GeoDistanceQuery) QueryName(queryName string) GeoDistanceQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the geo_distance query.
+func (q GeoDistanceQuery) Source() interface{} {
+	// {
+	//   "geo_distance" : {
+	//       "distance" : "200km",
+	//       "pin.location" : {
+	//           "lat" : 40,
+	//           "lon" : -70
+	//       }
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+
+	params := make(map[string]interface{})
+
+	if q.geohash != "" {
+		params[q.name] = q.geohash
+	} else {
+		location := make(map[string]interface{})
+		location["lat"] = q.lat
+		location["lon"] = q.lon
+		params[q.name] = location
+	}
+
+	if q.distance != "" {
+		params["distance"] = q.distance
+	}
+	if q.distanceType != "" {
+		params["distance_type"] = q.distanceType
+	}
+	if q.optimizeBbox != "" {
+		params["optimize_bbox"] = q.optimizeBbox
+	}
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+
+	source["geo_distance"] = params
+
+	return source
+}
@@ -0,0 +1,122 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// GeoShapeQuery matches documents whose geo_shape field has the given
+// relation to a shape, e.g. to find the delivery zones that contain
+// a point. The shape is either given as GeoJSON with Shape, or as a
+// reference to a shape indexed in another document with IndexedShape.
+//
+// For more details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-geo-shape-query.html
+type GeoShapeQuery struct {
+	Query
+	name              string
+	shape             interface{}
+	indexedShapeId    string
+	indexedShapeType  string
+	indexedShapeIndex string
+	indexedShapePath  string
+	relation          string
+	boost             *float32
+	queryName         string
+}
+
+// NewGeoShapeQuery creates a new geo_shape query for the given field.
+func NewGeoShapeQuery(name string) GeoShapeQuery {
+	q := GeoShapeQuery{name: name}
+	return q
+}
+
+// Shape sets the shape to match in GeoJSON format, e.g.
+//
+//	map[string]interface{}{
+//	  "type":        "envelope",
+//	  "coordinates": [][]float64{{13.0, 53.0}, {14.0, 52.0}},
+//	}
+func (q GeoShapeQuery) Shape(shape interface{}) GeoShapeQuery {
+	q.shape = shape
+	return q
+}
+
+// IndexedShape references a shape that is indexed in the document with
+// the given id and type in the given index. The path is the field of
+// that document containing the shape; Elasticsearch uses "shape" if
+// it is empty. Index defaults to "shapes" if it is empty.
+func (q GeoShapeQuery) IndexedShape(id, typ, index, path string) GeoShapeQuery {
+	q.indexedShapeId = id
+	q.indexedShapeType = typ
+	q.indexedShapeIndex = index
+	q.indexedShapePath = path
+	return q
+}
+
+// Relation sets the spatial relation between the shape of the documents
+// and the query shape: "intersects" (the default), "disjoint", "within"
+// or "contains".
+func (q GeoShapeQuery) Relation(relation string) GeoShapeQuery {
+	q.relation = relation
+	return q
+}
+
+// Boost sets the boost for this query.
+func (q GeoShapeQuery) Boost(boost float32) GeoShapeQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the geo_shape query that can be used
+// when searching for matched_filters per hit.
+func (q GeoShapeQuery) QueryName(queryName string) GeoShapeQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the geo_shape query.
+func (q GeoShapeQuery) Source() interface{} {
+	// {
+	//   "geo_shape" : {
+	//     "location" : {
+	//       "shape" : {
+	//         "type" : "envelope",
+	//         "coordinates" : [[13.0, 53.0], [14.0, 52.0]]
+	//       },
+	//       "relation" : "within"
+	//     }
+	//   }
+	// }
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["geo_shape"] = params
+
+	field := make(map[string]interface{})
+	params[q.name] = field
+
+	if q.indexedShapeId != "" {
+		indexed := make(map[string]interface{})
+		indexed["id"] = q.indexedShapeId
+		indexed["type"] = q.indexedShapeType
+		if q.indexedShapeIndex != "" {
+			indexed["index"] = q.indexedShapeIndex
+		}
+		if q.indexedShapePath != "" {
+			indexed["path"] = q.indexedShapePath
+		}
+		field["indexed_shape"] = indexed
+	} else if q.shape != nil {
+		field["shape"] = q.shape
+	}
+	if q.relation != "" {
+		field["relation"] = q.relation
+	}
+
+	if q.boost != nil {
+		params["boost"] = *q.boost
+	}
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+	return source
+}
@@ -0,0 +1,44 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestGeoShapeQuery(t *testing.T) {
+	q := NewGeoShapeQuery("location").
+		Shape(map[string]interface{}{
+			"type":        "envelope",
+			"coordinates": [][]float64{{13.0, 53.0}, {14.0, 52.0}},
+		}).
+		Relation("within")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_shape":{"location":{"relation":"within","shape":{"coordinates":[[13,53],[14,52]],"type":"envelope"}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestGeoShapeQueryWithIndexedShape(t *testing.T) {
+	q := NewGeoShapeQuery("zone").
+		IndexedShape("berlin", "city", "places", "area").
+		Relation("contains").
+		QueryName("my_query")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"geo_shape":{"_name":"my_query","zone":{"indexed_shape":{"id":"berlin","index":"places","path":"area","type":"city"},"relation":"contains"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -4,6 +4,10 @@
 
 package elastic
 
+import (
+	"fmt"
+)
+
 // The has_child query works the same as the has_child filter,
 // by automatically wrapping the filter with a constant_score
 // (when using the default score type).
@@ -13,6 +17,7 @@ type HasChildQuery struct {
 	query              Query
 	childType          string
 	boost              *float32
+	scoreMode          string
 	scoreType          string
 	minChildren        *int
 	maxChildren        *int
@@ -30,42 +35,76 @@ func NewHasChildQuery(childType string, query Query) HasChildQuery {
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q HasChildQuery) Boost(boost float32) HasChildQuery {
 	q.boost = &boost
 	return q
 }
 
+// ScoreMode specifies how the scores of matching childs are
+// aggregated, i.e. "none" (the default), "avg", "sum", "max", or "min".
+func (q HasChildQuery) ScoreMode(scoreMode string) HasChildQuery {
+	q.scoreMode = scoreMode
+	return q
+}
+
+// ScoreType is an alias of ScoreMode for older versions of Elasticsearch.
 func (q HasChildQuery) ScoreType(scoreType string) HasChildQuery {
 	q.scoreType = scoreType
 	return q
 }
 
+// MinChildren sets the minimum number of children that must match.
 func (q HasChildQuery) MinChildren(minChildren int) HasChildQuery {
 	q.minChildren = &minChildren
 	return q
 }
 
+// MaxChildren sets the maximum number of children that may match.
 func (q HasChildQuery) MaxChildren(maxChildren int) HasChildQuery {
 	q.maxChildren = &maxChildren
 	return q
 }
 
+// ShortCircuitCutoff sets the number of parent ids up to which the
+// query is executed with a terms filter instead of a bitset.
 func (q HasChildQuery) ShortCircuitCutoff(shortCircuitCutoff int) HasChildQuery {
 	q.shortCircuitCutoff = &shortCircuitCutoff
 	return q
 }
 
+// QueryName sets the query name for the has_child query that can be used
+// when searching for matched_filters per hit.
 func (q HasChildQuery) QueryName(queryName string) HasChildQuery {
 	q.queryName = queryName
 	return q
 }
 
+// InnerHit sets the inner hits definition for the matching childs.
 func (q HasChildQuery) InnerHit(innerHit *InnerHit) HasChildQuery {
 	q.innerHit = innerHit
 	return q
 }
 
-// Creates the query source for the ids query.
+// Validate checks if the query is valid, i.e. if the query and
+// the child type are set.
+func (q HasChildQuery) Validate() error {
+	var invalid []string
+	if q.query == nil {
+		invalid = append(invalid, "Query")
+	}
+	if q.childType == "" {
+		invalid = append(invalid, "ChildType")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the query source for the has_child query.
+// If Validate fails, the returned source can't be serialized,
+// i.e. sending the query returns the error of Validate.
 func (q HasChildQuery) Source() interface{} {
 	// {
 	//   "has_child" : {
@@ -77,16 +116,25 @@ func (q HasChildQuery) Source() interface{} {
 	//       }
 	//   }
 	// }
+	if err := q.Validate(); err != nil {
+		return invalidQuery{err: fmt.Errorf("elastic: invalid has_child query: %v", err)}
+	}
+
 	source := make(map[string]interface{})
 
 	query := make(map[string]interface{})
 	source["has_child"] = query
 
-	query["query"] = q.query.Source()
+	if q.query != nil {
+		query["query"] = q.query.Source()
+	}
 	query["type"] = q.childType
 	if q.boost != nil {
 		query["boost"] = *q.boost
 	}
+	if q.scoreMode != "" {
+		query["score_mode"] = q.scoreMode
+	}
 	if q.scoreType != "" {
 		query["score_type"] = q.scoreType
 	}
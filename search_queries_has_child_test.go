@@ -35,3 +35,28 @@ func TestHasChildQueryWithInnerHit(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHasChildQueryWithScoreModeAndChildren(t *testing.T) {
+	q := NewHasChildQuery("comment", NewTermQuery("user", "olivere")).ScoreMode("max").MinChildren(2).MaxChildren(10)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"has_child":{"max_children":10,"min_children":2,"query":{"term":{"user":"olivere"}},"score_mode":"max","type":"comment"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHasChildQueryValidate(t *testing.T) {
+	if err := NewHasChildQuery("", nil).Validate(); err == nil {
+		t.Errorf("expected Validate to fail without type and query")
+	}
+	if err := NewHasChildQuery("comment", NewMatchAllQuery()).Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+	if _, err := json.Marshal(NewHasChildQuery("comment", nil).Source()); err == nil {
+		t.Errorf("expected serializing a query without inner query to fail")
+	}
+}
@@ -4,6 +4,10 @@
 
 package elastic
 
+import (
+	"fmt"
+)
+
 // The has_parent query works the same as the has_parent filter,
 // by automatically wrapping the filter with a
 // constant_score (when using the default score type).
@@ -14,6 +18,7 @@ type HasParentQuery struct {
 	query      Query
 	parentType string
 	boost      *float32
+	scoreMode  string
 	scoreType  string
 	queryName  string
 	innerHit   *InnerHit
@@ -28,27 +33,57 @@ func NewHasParentQuery(parentType string, query Query) HasParentQuery {
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q HasParentQuery) Boost(boost float32) HasParentQuery {
 	q.boost = &boost
 	return q
 }
 
+// ScoreMode specifies how the scores of matching parents are
+// aggregated, i.e. "none" (the default) or "score".
+func (q HasParentQuery) ScoreMode(scoreMode string) HasParentQuery {
+	q.scoreMode = scoreMode
+	return q
+}
+
+// ScoreType is an alias of ScoreMode for older versions of Elasticsearch.
 func (q HasParentQuery) ScoreType(scoreType string) HasParentQuery {
 	q.scoreType = scoreType
 	return q
 }
 
+// QueryName sets the query name for the has_parent query that can be used
+// when searching for matched_filters per hit.
 func (q HasParentQuery) QueryName(queryName string) HasParentQuery {
 	q.queryName = queryName
 	return q
 }
 
+// InnerHit sets the inner hits definition for the matching parents.
 func (q HasParentQuery) InnerHit(innerHit *InnerHit) HasParentQuery {
 	q.innerHit = innerHit
 	return q
 }
 
-// Creates the query source for the ids query.
+// Validate checks if the query is valid, i.e. if the query and
+// the parent type are set.
+func (q HasParentQuery) Validate() error {
+	var invalid []string
+	if q.query == nil {
+		invalid = append(invalid, "Query")
+	}
+	if q.parentType == "" {
+		invalid = append(invalid, "ParentType")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the query source for the has_parent query.
+// If Validate fails, the returned source can't be serialized,
+// i.e. sending the query returns the error of Validate.
 func (q HasParentQuery) Source() interface{} {
 	// {
 	//   "has_parent" : {
@@ -60,16 +95,25 @@ func (q HasParentQuery) Source() interface{} {
 	//       }
 	//   }
 	// }
+	if err := q.Validate(); err != nil {
+		return invalidQuery{err: fmt.Errorf("elastic: invalid has_parent query: %v", err)}
+	}
+
 	source := make(map[string]interface{})
 
 	query := make(map[string]interface{})
 	source["has_parent"] = query
 
-	query["query"] = q.query.Source()
+	if q.query != nil {
+		query["query"] = q.query.Source()
+	}
 	query["parent_type"] = q.parentType
 	if q.boost != nil {
 		query["boost"] = *q.boost
 	}
+	if q.scoreMode != "" {
+		query["score_mode"] = q.scoreMode
+	}
 	if q.scoreType != "" {
 		query["score_type"] = q.scoreType
 	}
@@ -21,3 +21,28 @@ func TestHasParentQueryTest(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestHasParentQueryWithScoreMode(t *testing.T) {
+	q := NewHasParentQuery("blog", NewTermQuery("tag", "something")).ScoreMode("score")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"has_parent":{"parent_type":"blog","query":{"term":{"tag":"something"}},"score_mode":"score"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestHasParentQueryValidate(t *testing.T) {
+	if err := NewHasParentQuery("", nil).Validate(); err == nil {
+		t.Errorf("expected Validate to fail without type and query")
+	}
+	if err := NewHasParentQuery("blog", NewMatchAllQuery()).Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+	if _, err := json.Marshal(NewHasParentQuery("blog", nil).Source()); err == nil {
+		t.Errorf("expected serializing a query without inner query to fail")
+	}
+}
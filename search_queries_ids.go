@@ -17,7 +17,8 @@ type IdsQuery struct {
 	queryName string
 }
 
-// NewIdsQuery creates a new ids query.
+// NewIdsQuery creates a new ids query. If no types are given,
+// documents of all types are matched.
 func NewIdsQuery(types ...string) IdsQuery {
 	q := IdsQuery{
 		types:  types,
@@ -27,22 +28,26 @@ func NewIdsQuery(types ...string) IdsQuery {
 	return q
 }
 
+// Ids adds the document ids to match.
 func (q IdsQuery) Ids(ids ...string) IdsQuery {
 	q.values = append(q.values, ids...)
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q IdsQuery) Boost(boost float32) IdsQuery {
 	q.boost = boost
 	return q
 }
 
+// QueryName sets the query name for the ids query that can be used
+// when searching for matched_filters per hit.
 func (q IdsQuery) QueryName(queryName string) IdsQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the ids query.
+// Source returns the query source for the ids query.
 func (q IdsQuery) Source() interface{} {
 	// {
 	//	"ids" : {
@@ -21,3 +21,29 @@ func TestIdsQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestIdsQueryWithoutTypes(t *testing.T) {
+	q := NewIdsQuery().Ids("1", "2")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"ids":{"values":["1","2"]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestIdsQueryWithMultipleTypes(t *testing.T) {
+	q := NewIdsQuery("tweet", "comment").Ids("1")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"ids":{"types":["tweet","comment"],"values":["1"]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,97 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// IndicesQuery runs one query on the given indices and another query
+// (the no match query) on all other indices. It is useful when searching
+// several indices with different mappings in a single request.
+//
+// For details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-indices-query.html
+type IndicesQuery struct {
+	Query
+	query            Query
+	indices          []string
+	noMatchQueryType string
+	noMatchQuery     Query
+	queryName        string
+}
+
+// NewIndicesQuery creates a new indices query that runs query
+// on the given indices.
+func NewIndicesQuery(query Query, indices ...string) IndicesQuery {
+	q := IndicesQuery{
+		query:   query,
+		indices: make([]string, 0),
+	}
+	q.indices = append(q.indices, indices...)
+	return q
+}
+
+// Indices adds one or more indices to run the query on.
+func (q IndicesQuery) Indices(indices ...string) IndicesQuery {
+	q.indices = append(q.indices, indices...)
+	return q
+}
+
+// NoMatchQuery sets the query to run on all indices that are not
+// listed in Indices.
+func (q IndicesQuery) NoMatchQuery(query Query) IndicesQuery {
+	q.noMatchQuery = query
+	return q
+}
+
+// NoMatchQueryType is an alternative to NoMatchQuery: "all" matches
+// all documents of the other indices (the default), "none" matches none.
+func (q IndicesQuery) NoMatchQueryType(typ string) IndicesQuery {
+	q.noMatchQueryType = typ
+	return q
+}
+
+// QueryName sets the query name for the indices query that can be used
+// when searching for matched_filters per hit.
+func (q IndicesQuery) QueryName(queryName string) IndicesQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the indices query.
+func (q IndicesQuery) Source() interface{} {
+	// {
+	//   "indices" : {
+	//     "indices" : ["index1", "index2"],
+	//     "query" : {
+	//       "term" : { "tag" : "wow" }
+	//     },
+	//     "no_match_query" : {
+	//       "term" : { "tag" : "kow" }
+	//     }
+	//   }
+	// }
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["indices"] = params
+
+	if len(q.indices) == 1 {
+		params["index"] = q.indices[0]
+	} else {
+		params["indices"] = q.indices
+	}
+
+	if q.query != nil {
+		params["query"] = q.query.Source()
+	}
+
+	if q.noMatchQuery != nil {
+		params["no_match_query"] = q.noMatchQuery.Source()
+	} else if q.noMatchQueryType != "" {
+		params["no_match_query"] = q.noMatchQueryType
+	}
+
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+	return source
+}
@@ -57,26 +57,31 @@ func (q MatchQuery) Type(matchQueryType string) MatchQuery {
 	return q
 }
 
+// Operator can be "or" (the default) or "and".
 func (q MatchQuery) Operator(operator string) MatchQuery {
 	q.operator = operator
 	return q
 }
 
+// Analyzer sets the analyzer used to analyze the text.
 func (q MatchQuery) Analyzer(analyzer string) MatchQuery {
 	q.analyzer = analyzer
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q MatchQuery) Boost(boost float32) MatchQuery {
 	q.boost = &boost
 	return q
 }
 
+// Slop sets the number of positions the terms of a phrase may be apart.
 func (q MatchQuery) Slop(slop int) MatchQuery {
 	q.slop = &slop
 	return q
 }
 
+// Fuzziness sets the fuzziness for fuzzy matching, e.g. "AUTO" or "2".
 func (q MatchQuery) Fuzziness(fuzziness string) MatchQuery {
 	q.fuzziness = fuzziness
 	return q
@@ -92,6 +97,8 @@ func (q MatchQuery) MaxExpansions(maxExpansions int) MatchQuery {
 	return q
 }
 
+// MinimumShouldMatch specifies the number or percentage of
+// terms that must match.
 func (q MatchQuery) MinimumShouldMatch(minimumShouldMatch string) MatchQuery {
 	q.minimumShouldMatch = minimumShouldMatch
 	return q
@@ -133,19 +140,28 @@ func (q MatchQuery) QueryName(queryName string) MatchQuery {
 	return q
 }
 
+// Source returns the query source for the match query. Phrase and
+// phrase prefix queries are serialized as match_phrase and
+// match_phrase_prefix queries. If no options are set, the short form
+// {"match":{"name":"value"}} is used.
 func (q MatchQuery) Source() interface{} {
-	// {"match":{"name":{"query":"value","type":"boolean/phrase"}}}
+	// {"match":{"name":{"query":"value","type":"boolean"}}}
+	// {"match_phrase":{"name":{"query":"value","slop":2}}}
 	source := make(map[string]interface{})
 
 	match := make(map[string]interface{})
-	source["match"] = match
+	switch q.matchQueryType {
+	case "phrase":
+		source["match_phrase"] = match
+	case "phrase_prefix":
+		source["match_phrase_prefix"] = match
+	default:
+		source["match"] = match
+	}
 
 	query := make(map[string]interface{})
-	match[q.name] = query
-
-	query["query"] = q.value
 
-	if q.matchQueryType != "" {
+	if q.matchQueryType != "" && q.matchQueryType != "phrase" && q.matchQueryType != "phrase_prefix" {
 		query["type"] = q.matchQueryType
 	}
 	if q.operator != "" {
@@ -188,11 +204,18 @@ func (q MatchQuery) Source() interface{} {
 		query["zero_terms_query"] = q.zeroTermsQuery
 	}
 	if q.cutoffFrequency != nil {
-		query["cutoff_frequency"] = q.cutoffFrequency
+		query["cutoff_frequency"] = *q.cutoffFrequency
 	}
 	if q.queryName != "" {
 		query["_name"] = q.queryName
 	}
 
+	if len(query) == 0 {
+		match[q.name] = q.value
+	} else {
+		query["query"] = q.value
+		match[q.name] = query
+	}
+
 	return source
 }
@@ -16,7 +16,7 @@ func TestMatchQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"match":{"message":{"query":"this is a test"}}}`
+	expected := `{"match":{"message":"this is a test"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -29,7 +29,7 @@ func TestMatchPhraseQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"match":{"message":{"query":"this is a test","type":"phrase"}}}`
+	expected := `{"match_phrase":{"message":"this is a test"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -42,7 +42,7 @@ func TestMatchPhrasePrefixQuery(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"match":{"message":{"query":"this is a test","type":"phrase_prefix"}}}`
+	expected := `{"match_phrase_prefix":{"message":"this is a test"}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -60,3 +60,29 @@ func TestMatchQueryWithOptions(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestMatchQueryWithFuzzinessAndMinimumShouldMatch(t *testing.T) {
+	q := NewMatchQuery("message", "this is a test").Operator("and").Fuzziness("AUTO").MinimumShouldMatch("75%")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match":{"message":{"fuzziness":"AUTO","minimum_should_match":"75%","operator":"and","query":"this is a test"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMatchPhraseQueryWithSlop(t *testing.T) {
+	q := NewMatchPhraseQuery("message", "this is a test").Slop(2)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match_phrase":{"message":{"query":"this is a test","slop":2}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
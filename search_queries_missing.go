@@ -0,0 +1,70 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// MissingQuery matches documents where a specific field has no value in them.
+// Notice that the missing query requires Elasticsearch 2.0 or later. With
+// earlier versions, use a MissingFilter inside a ConstantScoreQuery or
+// FilteredQuery instead.
+// For details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-missing-query.html
+type MissingQuery struct {
+	Query
+	name      string
+	queryName string
+	nullValue *bool
+	existence *bool
+}
+
+// NewMissingQuery creates a new missing query for the given field.
+func NewMissingQuery(name string) MissingQuery {
+	q := MissingQuery{name: name}
+	return q
+}
+
+// QueryName sets the query name for the missing query that can be used
+// when searching for matched_filters per hit.
+func (q MissingQuery) QueryName(queryName string) MissingQuery {
+	q.queryName = queryName
+	return q
+}
+
+// NullValue indicates whether fields with an explicit null value
+// are considered missing. The default is false.
+func (q MissingQuery) NullValue(nullValue bool) MissingQuery {
+	q.nullValue = &nullValue
+	return q
+}
+
+// Existence indicates whether fields without any value are
+// considered missing. The default is true.
+func (q MissingQuery) Existence(existence bool) MissingQuery {
+	q.existence = &existence
+	return q
+}
+
+// Source returns the query source for the missing query.
+func (q MissingQuery) Source() interface{} {
+	// {
+	//   "missing" : {
+	//     "field" : "..."
+	//   }
+	// }
+
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["missing"] = params
+	params["field"] = q.name
+	if q.nullValue != nil {
+		params["null_value"] = *q.nullValue
+	}
+	if q.existence != nil {
+		params["existence"] = *q.existence
+	}
+	if q.queryName != "" {
+		params["_name"] = q.queryName
+	}
+	return source
+}
@@ -12,6 +12,7 @@ type MoreLikeThisQuery struct {
 
 	fields                 []string
 	likeText               string
+	ids                    []string
 	percentTermsToMatch    *float32
 	minTermFreq            *int
 	maxQueryTerms          *int
@@ -26,7 +27,8 @@ type MoreLikeThisQuery struct {
 	failOnUnsupportedField *bool
 }
 
-// Creates a new mlt query.
+// NewMoreLikeThisQuery creates a new mlt query. Pass an empty likeText
+// and use Ids to find documents that are like the given documents.
 func NewMoreLikeThisQuery(likeText string) MoreLikeThisQuery {
 	q := MoreLikeThisQuery{
 		likeText:  likeText,
@@ -36,11 +38,13 @@ func NewMoreLikeThisQuery(likeText string) MoreLikeThisQuery {
 	return q
 }
 
+// Field adds a field to fetch and analyze the text from.
 func (q MoreLikeThisQuery) Field(field string) MoreLikeThisQuery {
 	q.fields = append(q.fields, field)
 	return q
 }
 
+// Fields adds fields to fetch and analyze the text from.
 func (q MoreLikeThisQuery) Fields(fields ...string) MoreLikeThisQuery {
 	q.fields = append(q.fields, fields...)
 	return q
@@ -56,26 +60,40 @@ func (q MoreLikeThisQuery) StopWords(stopWords ...string) MoreLikeThisQuery {
 	return q
 }
 
+// LikeText sets the text to find documents like it.
 func (q MoreLikeThisQuery) LikeText(likeText string) MoreLikeThisQuery {
 	q.likeText = likeText
 	return q
 }
 
+// Ids adds the ids of documents to find documents like them.
+// The documents must be in the index and type that is searched.
+func (q MoreLikeThisQuery) Ids(ids ...string) MoreLikeThisQuery {
+	q.ids = append(q.ids, ids...)
+	return q
+}
+
 func (q MoreLikeThisQuery) PercentTermsToMatch(percentTermsToMatch float32) MoreLikeThisQuery {
 	q.percentTermsToMatch = &percentTermsToMatch
 	return q
 }
 
+// MinTermFreq sets the frequency below which terms are ignored
+// in the input. The default is 2.
 func (q MoreLikeThisQuery) MinTermFreq(minTermFreq int) MoreLikeThisQuery {
 	q.minTermFreq = &minTermFreq
 	return q
 }
 
+// MaxQueryTerms sets the maximum number of terms that are selected
+// from the input. The default is 25.
 func (q MoreLikeThisQuery) MaxQueryTerms(maxQueryTerms int) MoreLikeThisQuery {
 	q.maxQueryTerms = &maxQueryTerms
 	return q
 }
 
+// MinDocFreq sets the document frequency below which terms are
+// ignored in the input. The default is 5.
 func (q MoreLikeThisQuery) MinDocFreq(minDocFreq int) MoreLikeThisQuery {
 	q.minDocFreq = &minDocFreq
 	return q
@@ -116,10 +134,15 @@ func (q MoreLikeThisQuery) FailOnUnsupportedField(fail bool) MoreLikeThisQuery {
 	return q
 }
 
-// Creates the query source for the mlt query.
+// Source returns the query source for the mlt query.
 func (q MoreLikeThisQuery) Source() interface{} {
 	// {
-	//   "match_all" : { ... }
+	//   "more_like_this" : {
+	//     "fields" : ["name.first", "name.last"],
+	//     "like_text" : "text like this one",
+	//     "min_term_freq" : 1,
+	//     "max_query_terms" : 12
+	//   }
 	// }
 
 	source := make(map[string]interface{})
@@ -131,7 +154,13 @@ func (q MoreLikeThisQuery) Source() interface{} {
 		params["fields"] = q.fields
 	}
 
-	params["like_text"] = q.likeText
+	if q.likeText != "" {
+		params["like_text"] = q.likeText
+	}
+
+	if len(q.ids) > 0 {
+		params["ids"] = q.ids
+	}
 
 	if q.percentTermsToMatch != nil {
 		params["percent_terms_to_match"] = *q.percentTermsToMatch
@@ -5,9 +5,36 @@
 package elastic
 
 import (
+	"encoding/json"
 	"testing"
 )
 
+func TestMoreLikeThisQuerySource(t *testing.T) {
+	q := NewMoreLikeThisQuery("Golang topic.").Fields("message").MinTermFreq(1).MinDocFreq(1).MaxQueryTerms(12)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"more_like_this":{"fields":["message"],"like_text":"Golang topic.","max_query_terms":12,"min_doc_freq":1,"min_term_freq":1}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestMoreLikeThisQuerySourceWithIds(t *testing.T) {
+	q := NewMoreLikeThisQuery("").Fields("message").Ids("1", "2")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"more_like_this":{"fields":["message"],"ids":["1","2"]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestMoreLikeThis(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 )
 
@@ -36,6 +37,8 @@ type MultiMatchQuery struct {
 	queryName          string
 }
 
+// NewMultiMatchQuery creates a new multi_match query for the given
+// text and fields.
 func NewMultiMatchQuery(text interface{}, fields ...string) MultiMatchQuery {
 	q := MultiMatchQuery{
 		text:        text,
@@ -46,11 +49,14 @@ func NewMultiMatchQuery(text interface{}, fields ...string) MultiMatchQuery {
 	return q
 }
 
+// Field adds a field to run the query against.
 func (q MultiMatchQuery) Field(field string) MultiMatchQuery {
 	q.fields = append(q.fields, field)
 	return q
 }
 
+// FieldWithBoost adds a field to run the query against, and boosts it,
+// e.g. "subject^2.5".
 func (q MultiMatchQuery) FieldWithBoost(field string, boost float32) MultiMatchQuery {
 	q.fields = append(q.fields, field)
 	q.fieldBoosts[field] = &boost
@@ -139,6 +145,8 @@ func (q MultiMatchQuery) UseDisMax(useDisMax bool) MultiMatchQuery {
 	return q
 }
 
+// TieBreaker sets the tie breaker for best_fields and cross_fields
+// queries. Each call to Type resets it to the default of the type.
 func (q MultiMatchQuery) TieBreaker(tieBreaker float32) MultiMatchQuery {
 	q.tieBreaker = &tieBreaker
 	return q
@@ -165,6 +173,7 @@ func (q MultiMatchQuery) QueryName(queryName string) MultiMatchQuery {
 	return q
 }
 
+// Source returns the query source for the multi_match query.
 func (q MultiMatchQuery) Source() interface{} {
 	//
 	// {
@@ -186,7 +195,7 @@ func (q MultiMatchQuery) Source() interface{} {
 		for _, field := range q.fields {
 			if boost, found := q.fieldBoosts[field]; found {
 				if boost != nil {
-					fields = append(fields, fmt.Sprintf("%s^%f", field, *boost))
+					fields = append(fields, fmt.Sprintf("%s^%s", field, strconv.FormatFloat(float64(*boost), 'f', -1, 32)))
 				} else {
 					fields = append(fields, field)
 				}
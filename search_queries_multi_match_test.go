@@ -101,3 +101,20 @@ func TestMultiMatchQueryBestFieldsWithCustomTieBreaker(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestMultiMatchQueryWithFieldBoosts(t *testing.T) {
+	q := NewMultiMatchQuery("this is a test").
+		FieldWithBoost("subject", 2).
+		FieldWithBoost("title", 1.5).
+		Field("message").
+		Type("cross_fields")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"multi_match":{"fields":["subject^2","title^1.5","message"],"query":"this is a test","tie_breaker":0,"type":"cross_fields"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
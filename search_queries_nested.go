@@ -21,47 +21,57 @@ type NestedQuery struct {
 	innerHit  *InnerHit
 }
 
-// Creates a new nested_query query.
+// NewNestedQuery creates a new nested query for the given path.
 func NewNestedQuery(path string) NestedQuery {
 	return NestedQuery{path: path}
 }
 
+// Query sets the query to run against the nested objects.
 func (q NestedQuery) Query(query Query) NestedQuery {
 	q.query = query
 	return q
 }
 
+// Filter sets the filter to run against the nested objects.
 func (q NestedQuery) Filter(filter Filter) NestedQuery {
 	q.filter = filter
 	return q
 }
 
+// Path sets the path to the nested objects.
 func (q NestedQuery) Path(path string) NestedQuery {
 	q.path = path
 	return q
 }
 
+// ScoreMode specifies how the scores of matching nested objects affect
+// the score of the root document, i.e. "avg" (the default), "sum", "max",
+// or "none".
 func (q NestedQuery) ScoreMode(scoreMode string) NestedQuery {
 	q.scoreMode = scoreMode
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q NestedQuery) Boost(boost float32) NestedQuery {
 	q.boost = &boost
 	return q
 }
 
+// QueryName sets the query name for the nested query that can be used
+// when searching for matched_filters per hit.
 func (q NestedQuery) QueryName(queryName string) NestedQuery {
 	q.queryName = queryName
 	return q
 }
 
+// InnerHit sets the inner hits definition for the nested objects.
 func (q NestedQuery) InnerHit(innerHit *InnerHit) NestedQuery {
 	q.innerHit = innerHit
 	return q
 }
 
-// Creates the query source for the nested_query query.
+// Source returns the query source for the nested query.
 func (q NestedQuery) Source() interface{} {
 	// {
 	//   "nested" : {
@@ -17,28 +17,32 @@ type PrefixQuery struct {
 	queryName string
 }
 
-// Creates a new prefix query.
+// NewPrefixQuery creates a new prefix query.
 func NewPrefixQuery(name string, prefix string) PrefixQuery {
 	q := PrefixQuery{name: name, prefix: prefix}
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q PrefixQuery) Boost(boost float32) PrefixQuery {
 	q.boost = &boost
 	return q
 }
 
+// Rewrite sets the rewrite method, e.g. "constant_score_auto".
 func (q PrefixQuery) Rewrite(rewrite string) PrefixQuery {
 	q.rewrite = rewrite
 	return q
 }
 
+// QueryName sets the query name for the prefix query that can be used
+// when searching for matched_filters per hit.
 func (q PrefixQuery) QueryName(queryName string) PrefixQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the prefix query.
+// Source returns the query source for the prefix query.
 func (q PrefixQuery) Source() interface{} {
 	// {
 	//   "prefix" : {
@@ -6,6 +6,7 @@ package elastic
 
 import (
 	"fmt"
+	"strconv"
 )
 
 // A query that uses the query parser in order to parse
@@ -40,7 +41,8 @@ type QueryStringQuery struct {
 	lenient                   *bool
 }
 
-// Creates a new query string query.
+// NewQueryStringQuery creates a new query string query. The query string
+// is passed to Elasticsearch as is, i.e. it is not escaped.
 func NewQueryStringQuery(queryString string) QueryStringQuery {
 	q := QueryStringQuery{
 		queryString: queryString,
@@ -50,16 +52,27 @@ func NewQueryStringQuery(queryString string) QueryStringQuery {
 	return q
 }
 
+// DefaultField sets the field to run the query against when no prefix
+// field is specified in the query string.
 func (q QueryStringQuery) DefaultField(defaultField string) QueryStringQuery {
 	q.defaultField = defaultField
 	return q
 }
 
+// Field adds a field to run the query against.
 func (q QueryStringQuery) Field(field string) QueryStringQuery {
 	q.fields = append(q.fields, field)
 	return q
 }
 
+// Fields adds one or more fields to run the query against.
+func (q QueryStringQuery) Fields(fields ...string) QueryStringQuery {
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// FieldWithBoost adds a field to run the query against, and boosts it,
+// e.g. "subject^2.5".
 func (q QueryStringQuery) FieldWithBoost(field string, boost float32) QueryStringQuery {
 	q.fields = append(q.fields, field)
 	q.fieldBoosts[field] = &boost
@@ -76,6 +89,8 @@ func (q QueryStringQuery) TieBreaker(tieBreaker float32) QueryStringQuery {
 	return q
 }
 
+// DefaultOperator sets the operator used when no explicit operator
+// is specified, i.e. "OR" (the default) or "AND".
 func (q QueryStringQuery) DefaultOperator(operator string) QueryStringQuery {
 	q.defaultOper = operator
 	return q
@@ -96,6 +111,8 @@ func (q QueryStringQuery) AutoGeneratePhraseQueries(autoGeneratePhraseQueries bo
 	return q
 }
 
+// AllowLeadingWildcard indicates whether * or ? are allowed as the
+// first character of a term.
 func (q QueryStringQuery) AllowLeadingWildcard(allowLeadingWildcard bool) QueryStringQuery {
 	q.allowLeadingWildcard = &allowLeadingWildcard
 	return q
@@ -131,6 +148,7 @@ func (q QueryStringQuery) PhraseSlop(phraseSlop int) QueryStringQuery {
 	return q
 }
 
+// AnalyzeWildcard indicates whether terms with wildcards are analyzed.
 func (q QueryStringQuery) AnalyzeWildcard(analyzeWildcard bool) QueryStringQuery {
 	q.analyzeWildcard = &analyzeWildcard
 	return q
@@ -161,7 +179,21 @@ func (q QueryStringQuery) Lenient(lenient bool) QueryStringQuery {
 	return q
 }
 
-// Creates the query source for the query string query.
+// Validate checks if the query is valid, i.e. if the query string is set.
+func (q QueryStringQuery) Validate() error {
+	var invalid []string
+	if q.queryString == "" {
+		invalid = append(invalid, "QueryString")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Source returns the query source for the query string query.
+// If Validate fails, the returned source can't be serialized,
+// i.e. sending the query returns the error of Validate.
 func (q QueryStringQuery) Source() interface{} {
 	// {
 	//    "query_string" : {
@@ -170,6 +202,10 @@ func (q QueryStringQuery) Source() interface{} {
 	//    }
 	// }
 
+	if err := q.Validate(); err != nil {
+		return invalidQuery{err: fmt.Errorf("elastic: invalid query_string query: %v", err)}
+	}
+
 	source := make(map[string]interface{})
 
 	query := make(map[string]interface{})
@@ -186,7 +222,7 @@ func (q QueryStringQuery) Source() interface{} {
 		for _, field := range q.fields {
 			if boost, found := q.fieldBoosts[field]; found {
 				if boost != nil {
-					fields = append(fields, fmt.Sprintf("%s^%f", field, *boost))
+					fields = append(fields, fmt.Sprintf("%s^%s", field, strconv.FormatFloat(float64(*boost), 'f', -1, 32)))
 				} else {
 					fields = append(fields, field)
 				}
@@ -22,3 +22,33 @@ func TestQueryStringQuery(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestQueryStringQueryWithOptions(t *testing.T) {
+	q := NewQueryStringQuery(`*olang AND elastic*`).
+		Fields("subject", "message").
+		FieldWithBoost("title", 2).
+		DefaultOperator("AND").
+		AnalyzeWildcard(true).
+		AllowLeadingWildcard(true)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query_string":{"allow_leading_wildcard":true,"analyze_wildcard":true,"default_operator":"AND","fields":["subject","message","title^2"],"query":"*olang AND elastic*"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestQueryStringQueryValidate(t *testing.T) {
+	if err := NewQueryStringQuery("").Validate(); err == nil {
+		t.Errorf("expected Validate to fail with an empty query string")
+	}
+	if err := NewQueryStringQuery("golang").Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+	if _, err := json.Marshal(NewQueryStringQuery("").Source()); err == nil {
+		t.Errorf("expected serializing an empty query string to fail")
+	}
+}
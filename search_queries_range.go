@@ -13,76 +13,100 @@ type RangeQuery struct {
 	from         *interface{}
 	to           *interface{}
 	timeZone     string
+	format       string
 	includeLower bool
 	includeUpper bool
 	boost        *float64
 	queryName    string
 }
 
+// NewRangeQuery creates a new range query for the given field.
 func NewRangeQuery(name string) RangeQuery {
 	q := RangeQuery{name: name, includeLower: true, includeUpper: true}
 	return q
 }
 
-func (f RangeQuery) TimeZone(timeZone string) RangeQuery {
-	f.timeZone = timeZone
-	return f
+// TimeZone sets the time zone used to convert date values in the query,
+// e.g. "+01:00". It only applies to date fields.
+func (q RangeQuery) TimeZone(timeZone string) RangeQuery {
+	q.timeZone = timeZone
+	return q
+}
+
+// Format sets the format used to parse date values in the query,
+// e.g. "yyyy-MM-dd". It only applies to date fields.
+func (q RangeQuery) Format(format string) RangeQuery {
+	q.format = format
+	return q
 }
 
+// From sets the lower bound of the range.
 func (q RangeQuery) From(from interface{}) RangeQuery {
 	q.from = &from
 	return q
 }
 
+// Gt sets the (exclusive) lower bound of the range.
 func (q RangeQuery) Gt(from interface{}) RangeQuery {
 	q.from = &from
 	q.includeLower = false
 	return q
 }
 
+// Gte sets the (inclusive) lower bound of the range.
 func (q RangeQuery) Gte(from interface{}) RangeQuery {
 	q.from = &from
 	q.includeLower = true
 	return q
 }
 
+// To sets the upper bound of the range.
 func (q RangeQuery) To(to interface{}) RangeQuery {
 	q.to = &to
 	return q
 }
 
+// Lt sets the (exclusive) upper bound of the range.
 func (q RangeQuery) Lt(to interface{}) RangeQuery {
 	q.to = &to
 	q.includeUpper = false
 	return q
 }
 
+// Lte sets the (inclusive) upper bound of the range.
 func (q RangeQuery) Lte(to interface{}) RangeQuery {
 	q.to = &to
 	q.includeUpper = true
 	return q
 }
 
+// IncludeLower indicates whether the lower bound is part of the range.
 func (q RangeQuery) IncludeLower(includeLower bool) RangeQuery {
 	q.includeLower = includeLower
 	return q
 }
 
+// IncludeUpper indicates whether the upper bound is part of the range.
 func (q RangeQuery) IncludeUpper(includeUpper bool) RangeQuery {
 	q.includeUpper = includeUpper
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q RangeQuery) Boost(boost float64) RangeQuery {
 	q.boost = &boost
 	return q
 }
 
+// QueryName sets the query name for the range query that can be used
+// when searching for matched_filters per hit.
 func (q RangeQuery) QueryName(queryName string) RangeQuery {
 	q.queryName = queryName
 	return q
 }
 
+// Source returns the query source for the range query.
+// Bounds that are not set are omitted.
 func (q RangeQuery) Source() interface{} {
 	// {
 	//   "range" : {
@@ -100,16 +124,23 @@ func (q RangeQuery) Source() interface{} {
 	params := make(map[string]interface{})
 	rangeQ[q.name] = params
 
-	params["from"] = q.from
-	params["to"] = q.to
+	if q.from != nil {
+		params["from"] = *q.from
+	}
+	if q.to != nil {
+		params["to"] = *q.to
+	}
 	if q.timeZone != "" {
 		params["time_zone"] = q.timeZone
 	}
+	if q.format != "" {
+		params["format"] = q.format
+	}
 	params["include_lower"] = q.includeLower
 	params["include_upper"] = q.includeUpper
 
 	if q.boost != nil {
-		rangeQ["boost"] = *q.boost
+		params["boost"] = *q.boost
 	}
 
 	if q.queryName != "" {
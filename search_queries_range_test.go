@@ -23,7 +23,6 @@ func TestRangeQuery(t *testing.T) {
 	}
 }
 
-/*
 func TestRangeQueryGte(t *testing.T) {
 	q := NewRangeQuery("postDate").Gte("2010-03-01")
 	data, err := json.Marshal(q.Source())
@@ -31,12 +30,24 @@ func TestRangeQueryGte(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"range":{"postDate":{"gte":"2010-03-01"}}}`
+	expected := `{"range":{"postDate":{"from":"2010-03-01","include_lower":true,"include_upper":true}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestRangeQueryLtWithBoost(t *testing.T) {
+	q := NewRangeQuery("age").Lt(20).Boost(2)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"range":{"age":{"boost":2,"include_lower":true,"include_upper":false,"to":20}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
-*/
 
 func TestRangeQueryWithTimeZone(t *testing.T) {
 	f := NewRangeQuery("born").
@@ -53,3 +64,19 @@ func TestRangeQueryWithTimeZone(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestRangeQueryWithFormat(t *testing.T) {
+	q := NewRangeQuery("born").
+		Gte("2012/01/01").
+		Lte("now").
+		Format("yyyy/MM/dd")
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"range":{"born":{"format":"yyyy/MM/dd","from":"2012/01/01","include_lower":true,"include_upper":true,"to":"now"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
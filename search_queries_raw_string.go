@@ -0,0 +1,37 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// RawStringQuery can be used to treat a string representation of an ES
+// query as a Query, e.g. a query copied from Kibana or one that no
+// builder in this package covers yet. Example:
+//
+//	q := elastic.RawStringQuery(`{"match":{"message":"golang"}}`)
+//	res, err := client.Search("twitter").Query(q).Do()
+//
+// The string is not validated before the request is sent. If it is not
+// valid JSON, serializing the request fails and the error is
+// returned from Do.
+type RawStringQuery string
+
+// NewRawStringQuery initializes a new RawStringQuery.
+// It is the same as RawStringQuery(q).
+func NewRawStringQuery(q string) RawStringQuery {
+	return RawStringQuery(q)
+}
+
+// Source returns the JSON encoded body.
+func (q RawStringQuery) Source() interface{} {
+	var f interface{}
+	if err := json.Unmarshal([]byte(q), &f); err != nil {
+		return invalidQuery{err: fmt.Errorf("elastic: raw string query is not valid JSON: %v", err)}
+	}
+	return f
+}
@@ -0,0 +1,49 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestRawStringQuery(t *testing.T) {
+	q := RawStringQuery(`{"match_all":{}}`)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"match_all":{}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestNewRawStringQueryInSearchSource(t *testing.T) {
+	q := NewRawStringQuery(`{"term":{"user":"olivere"}}`)
+	builder := NewSearchSource().Query(q)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"term":{"user":"olivere"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestRawStringQueryWithInvalidJSON(t *testing.T) {
+	q := RawStringQuery(`{"match_all":`)
+	_, err := json.Marshal(NewSearchSource().Query(q).Source())
+	if err == nil {
+		t.Fatal("expected error for invalid JSON")
+	}
+	if !strings.Contains(err.Error(), "raw string query is not valid JSON") {
+		t.Errorf("expected error about invalid raw string query; got: %v", err)
+	}
+}
@@ -31,21 +31,27 @@ func (q RegexpQuery) Flags(flags string) RegexpQuery {
 	return q
 }
 
+// MaxDeterminizedStates limits the number of automaton states
+// the regular expression may create.
 func (q RegexpQuery) MaxDeterminizedStates(maxDeterminizedStates int) RegexpQuery {
 	q.maxDeterminizedStates = &maxDeterminizedStates
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q RegexpQuery) Boost(boost float64) RegexpQuery {
 	q.boost = &boost
 	return q
 }
 
+// Rewrite sets the rewrite method, e.g. "constant_score_auto".
 func (q RegexpQuery) Rewrite(rewrite string) RegexpQuery {
 	q.rewrite = &rewrite
 	return q
 }
 
+// QueryName sets the query name for the regexp query that can be used
+// when searching for matched_filters per hit.
 func (q RegexpQuery) QueryName(queryName string) RegexpQuery {
 	q.queryName = &queryName
 	return q
@@ -81,7 +87,7 @@ func (q RegexpQuery) Source() interface{} {
 		x["rewrite"] = *q.rewrite
 	}
 	if q.queryName != nil {
-		x["name"] = *q.queryName
+		x["_name"] = *q.queryName
 	}
 	query[q.name] = x
 
@@ -32,7 +32,7 @@ func TestRegexpQueryWithOptions(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"regexp":{"name.first":{"boost":1.2,"flags":"INTERSECTION|COMPLEMENT|EMPTY","name":"my_query_name","value":"s.*y"}}}`
+	expected := `{"regexp":{"name.first":{"_name":"my_query_name","boost":1.2,"flags":"INTERSECTION|COMPLEMENT|EMPTY","value":"s.*y"}}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -12,15 +12,18 @@ import (
 // SimpleQueryStringQuery is a query that uses the SimpleQueryParser
 // to parse its context. Unlike the regular query_string query,
 // the simple_query_string query will never throw an exception,
-// and discards invalid parts of the query.
+// and discards invalid parts of the query. This makes it a good fit
+// for queries entered by users, e.g. in a public search box.
 // For more details, see
 // http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-simple-query-string-query.html
 type SimpleQueryStringQuery struct {
-	queryText   string
-	analyzer    string
-	operator    string
-	fields      []string
-	fieldBoosts map[string]*float32
+	queryText       string
+	analyzer        string
+	operator        string
+	fields          []string
+	fieldBoosts     map[string]*float32
+	flags           []string
+	analyzeWildcard *bool
 }
 
 // Creates a new simple query string query.
@@ -33,27 +36,56 @@ func NewSimpleQueryStringQuery(text string) SimpleQueryStringQuery {
 	return q
 }
 
+// Field adds a field to run the query against.
 func (q SimpleQueryStringQuery) Field(field string) SimpleQueryStringQuery {
 	q.fields = append(q.fields, field)
 	return q
 }
 
+// Fields adds one or more fields to run the query against,
+// e.g. "body^5" or "_all".
+func (q SimpleQueryStringQuery) Fields(fields ...string) SimpleQueryStringQuery {
+	q.fields = append(q.fields, fields...)
+	return q
+}
+
+// FieldWithBoost adds a field to run the query against with a
+// specific boost.
 func (q SimpleQueryStringQuery) FieldWithBoost(field string, boost float32) SimpleQueryStringQuery {
 	q.fields = append(q.fields, field)
 	q.fieldBoosts[field] = &boost
 	return q
 }
 
+// Analyzer sets the analyzer used to analyze the query text.
 func (q SimpleQueryStringQuery) Analyzer(analyzer string) SimpleQueryStringQuery {
 	q.analyzer = analyzer
 	return q
 }
 
+// DefaultOperator sets the operator used if no explicit operator
+// is given in the query text: "or" (the default) or "and".
 func (q SimpleQueryStringQuery) DefaultOperator(defaultOperator string) SimpleQueryStringQuery {
 	q.operator = defaultOperator
 	return q
 }
 
+// Flags restricts the syntax the parser accepts to the given features,
+// e.g. Flags("AND", "OR", "PREFIX") to only enable the +, | and *
+// operators. Valid flags are ALL, NONE, AND, OR, NOT, PREFIX, PHRASE,
+// PRECEDENCE, ESCAPE, WHITESPACE, FUZZY, NEAR and SLOP.
+// Flags may also be passed already combined, e.g. "AND|OR|PREFIX".
+func (q SimpleQueryStringQuery) Flags(flags ...string) SimpleQueryStringQuery {
+	q.flags = append(q.flags, flags...)
+	return q
+}
+
+// AnalyzeWildcard specifies whether prefix queries are analyzed.
+func (q SimpleQueryStringQuery) AnalyzeWildcard(analyzeWildcard bool) SimpleQueryStringQuery {
+	q.analyzeWildcard = &analyzeWildcard
+	return q
+}
+
 // Creates the query source for the query string query.
 func (q SimpleQueryStringQuery) Source() interface{} {
 	// {
@@ -61,7 +93,8 @@ func (q SimpleQueryStringQuery) Source() interface{} {
 	//      "query" : "\"fried eggs\" +(eggplant | potato) -frittata",
 	//			"analyzer" : "snowball",
 	//      "fields" : ["body^5","_all"],
-	//      "default_operator" : "and"
+	//      "default_operator" : "and",
+	//      "flags" : "AND|OR|PREFIX"
 	//    }
 	// }
 
@@ -96,5 +129,13 @@ func (q SimpleQueryStringQuery) Source() interface{} {
 		query["default_operator"] = strings.ToLower(q.operator)
 	}
 
+	if len(q.flags) > 0 {
+		query["flags"] = strings.ToUpper(strings.Join(q.flags, "|"))
+	}
+
+	if q.analyzeWildcard != nil {
+		query["analyze_wildcard"] = *q.analyzeWildcard
+	}
+
 	return source
 }
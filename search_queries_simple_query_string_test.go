@@ -22,6 +22,23 @@ func TestSimpleQueryStringQuery(t *testing.T) {
 	}
 }
 
+func TestSimpleQueryStringQueryWithOptions(t *testing.T) {
+	q := NewSimpleQueryStringQuery("golang elas*").
+		Fields("message^5", "_all").
+		DefaultOperator("AND").
+		Flags("and", "OR|PREFIX").
+		AnalyzeWildcard(true)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"simple_query_string":{"analyze_wildcard":true,"default_operator":"and","fields":["message^5","_all"],"flags":"AND|OR|PREFIX","query":"golang elas*"}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSimpleQueryStringQueryExec(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -0,0 +1,62 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// SpanFirstQuery matches spans near the beginning of a field. The match
+// must be a span query, and it must end at or before position end.
+// For more details, see
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-span-first-query.html
+type SpanFirstQuery struct {
+	match     Query
+	end       int
+	boost     *float32
+	queryName string
+}
+
+// NewSpanFirstQuery creates a new span_first query.
+func NewSpanFirstQuery(match Query, end int) SpanFirstQuery {
+	return SpanFirstQuery{match: match, end: end}
+}
+
+// Boost sets the boost for this query.
+func (q SpanFirstQuery) Boost(boost float32) SpanFirstQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the span_first query that can be used
+// when searching for matched_filters per hit.
+func (q SpanFirstQuery) QueryName(queryName string) SpanFirstQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the span_first query.
+func (q SpanFirstQuery) Source() interface{} {
+	// {
+	//   "span_first" : {
+	//     "match" : {
+	//       "span_term" : { "user" : "kimchy" }
+	//     },
+	//     "end" : 3
+	//   }
+	// }
+	source := make(map[string]interface{})
+	fq := make(map[string]interface{})
+	source["span_first"] = fq
+
+	if q.match != nil {
+		fq["match"] = q.match.Source()
+	}
+	fq["end"] = q.end
+
+	if q.boost != nil {
+		fq["boost"] = *q.boost
+	}
+	if q.queryName != "" {
+		fq["_name"] = q.queryName
+	}
+	return source
+}
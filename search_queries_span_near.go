@@ -0,0 +1,106 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// SpanNearQuery matches spans which are near one another. The maximum
+// number of intervening unmatched positions is set with Slop, and
+// InOrder specifies whether the clauses must match in the given order.
+// The clauses must be span queries, e.g. SpanTermQuery.
+// For more details, see
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-span-near-query.html
+type SpanNearQuery struct {
+	clauses         []Query
+	slop            *int
+	inOrder         *bool
+	collectPayloads *bool
+	boost           *float32
+	queryName       string
+}
+
+// NewSpanNearQuery creates a new span_near query with the given clauses.
+func NewSpanNearQuery(clauses ...Query) SpanNearQuery {
+	q := SpanNearQuery{
+		clauses: make([]Query, 0),
+	}
+	q.clauses = append(q.clauses, clauses...)
+	return q
+}
+
+// Add adds one or more span query clauses.
+func (q SpanNearQuery) Add(clauses ...Query) SpanNearQuery {
+	q.clauses = append(q.clauses, clauses...)
+	return q
+}
+
+// Slop is the maximum number of intervening unmatched positions.
+func (q SpanNearQuery) Slop(slop int) SpanNearQuery {
+	q.slop = &slop
+	return q
+}
+
+// InOrder specifies whether the clauses must match in order.
+func (q SpanNearQuery) InOrder(inOrder bool) SpanNearQuery {
+	q.inOrder = &inOrder
+	return q
+}
+
+// CollectPayloads specifies whether payloads are loaded.
+func (q SpanNearQuery) CollectPayloads(collectPayloads bool) SpanNearQuery {
+	q.collectPayloads = &collectPayloads
+	return q
+}
+
+// Boost sets the boost for this query.
+func (q SpanNearQuery) Boost(boost float32) SpanNearQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the span_near query that can be used
+// when searching for matched_filters per hit.
+func (q SpanNearQuery) QueryName(queryName string) SpanNearQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the span_near query.
+func (q SpanNearQuery) Source() interface{} {
+	// {
+	//   "span_near" : {
+	//     "clauses" : [
+	//       { "span_term" : { "field" : "value1" } },
+	//       { "span_term" : { "field" : "value2" } }
+	//     ],
+	//     "slop" : 12,
+	//     "in_order" : false
+	//   }
+	// }
+	source := make(map[string]interface{})
+	nq := make(map[string]interface{})
+	source["span_near"] = nq
+
+	clauses := make([]interface{}, 0)
+	for _, clause := range q.clauses {
+		clauses = append(clauses, clause.Source())
+	}
+	nq["clauses"] = clauses
+
+	if q.slop != nil {
+		nq["slop"] = *q.slop
+	}
+	if q.inOrder != nil {
+		nq["in_order"] = *q.inOrder
+	}
+	if q.collectPayloads != nil {
+		nq["collect_payloads"] = *q.collectPayloads
+	}
+	if q.boost != nil {
+		nq["boost"] = *q.boost
+	}
+	if q.queryName != "" {
+		nq["_name"] = q.queryName
+	}
+	return source
+}
@@ -0,0 +1,36 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSpanNearQuery(t *testing.T) {
+	q := NewSpanNearQuery(NewSpanTermQuery("field", "value1"), NewSpanTermQuery("field", "value2")).Slop(12).InOrder(false)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"span_near":{"clauses":[{"span_term":{"field":"value1"}},{"span_term":{"field":"value2"}}],"in_order":false,"slop":12}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSpanNearQueryWithNestedSpans(t *testing.T) {
+	q := NewSpanNearQuery(NewSpanTermQuery("claims", "rotor")).Add(NewSpanOrQuery(NewSpanTermQuery("claims", "blade"), NewSpanTermQuery("claims", "vane"))).Slop(3).InOrder(true)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"span_near":{"clauses":[{"span_term":{"claims":"rotor"}},{"span_or":{"clauses":[{"span_term":{"claims":"blade"}},{"span_term":{"claims":"vane"}}]}}],"in_order":true,"slop":3}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// SpanNotQuery removes matches of the include span query which overlap
+// with matches of the exclude span query.
+// For more details, see
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-span-not-query.html
+type SpanNotQuery struct {
+	include   Query
+	exclude   Query
+	boost     *float32
+	queryName string
+}
+
+// NewSpanNotQuery creates a new span_not query.
+func NewSpanNotQuery(include, exclude Query) SpanNotQuery {
+	return SpanNotQuery{include: include, exclude: exclude}
+}
+
+// Boost sets the boost for this query.
+func (q SpanNotQuery) Boost(boost float32) SpanNotQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the span_not query that can be used
+// when searching for matched_filters per hit.
+func (q SpanNotQuery) QueryName(queryName string) SpanNotQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the span_not query.
+func (q SpanNotQuery) Source() interface{} {
+	// {
+	//   "span_not" : {
+	//     "include" : {
+	//       "span_term" : { "field1" : "hoya" }
+	//     },
+	//     "exclude" : {
+	//       "span_term" : { "field2" : "la" }
+	//     }
+	//   }
+	// }
+	source := make(map[string]interface{})
+	nq := make(map[string]interface{})
+	source["span_not"] = nq
+
+	if q.include != nil {
+		nq["include"] = q.include.Source()
+	}
+	if q.exclude != nil {
+		nq["exclude"] = q.exclude.Source()
+	}
+
+	if q.boost != nil {
+		nq["boost"] = *q.boost
+	}
+	if q.queryName != "" {
+		nq["_name"] = q.queryName
+	}
+	return source
+}
@@ -0,0 +1,23 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSpanNotQuery(t *testing.T) {
+	q := NewSpanNotQuery(NewSpanNearQuery(NewSpanTermQuery("field1", "hoya"), NewSpanTermQuery("field1", "la")).Slop(0).InOrder(true), NewSpanTermQuery("field2", "la"))
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"span_not":{"exclude":{"span_term":{"field2":"la"}},"include":{"span_near":{"clauses":[{"span_term":{"field1":"hoya"}},{"span_term":{"field1":"la"}}],"in_order":true,"slop":0}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// SpanOrQuery matches the union of its span clauses.
+// For more details, see
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-span-or-query.html
+type SpanOrQuery struct {
+	clauses   []Query
+	boost     *float32
+	queryName string
+}
+
+// NewSpanOrQuery creates a new span_or query with the given clauses.
+func NewSpanOrQuery(clauses ...Query) SpanOrQuery {
+	q := SpanOrQuery{
+		clauses: make([]Query, 0),
+	}
+	q.clauses = append(q.clauses, clauses...)
+	return q
+}
+
+// Add adds one or more span query clauses.
+func (q SpanOrQuery) Add(clauses ...Query) SpanOrQuery {
+	q.clauses = append(q.clauses, clauses...)
+	return q
+}
+
+// Boost sets the boost for this query.
+func (q SpanOrQuery) Boost(boost float32) SpanOrQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the span_or query that can be used
+// when searching for matched_filters per hit.
+func (q SpanOrQuery) QueryName(queryName string) SpanOrQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the span_or query.
+func (q SpanOrQuery) Source() interface{} {
+	// {
+	//   "span_or" : {
+	//     "clauses" : [
+	//       { "span_term" : { "field" : "value1" } },
+	//       { "span_term" : { "field" : "value2" } }
+	//     ]
+	//   }
+	// }
+	source := make(map[string]interface{})
+	oq := make(map[string]interface{})
+	source["span_or"] = oq
+
+	clauses := make([]interface{}, 0)
+	for _, clause := range q.clauses {
+		clauses = append(clauses, clause.Source())
+	}
+	oq["clauses"] = clauses
+
+	if q.boost != nil {
+		oq["boost"] = *q.boost
+	}
+	if q.queryName != "" {
+		oq["_name"] = q.queryName
+	}
+	return source
+}
@@ -0,0 +1,23 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSpanOrQuery(t *testing.T) {
+	q := NewSpanOrQuery(NewSpanTermQuery("field", "value1")).Add(NewSpanTermQuery("field", "value2")).Boost(1.5)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"span_or":{"boost":1.5,"clauses":[{"span_term":{"field":"value1"}},{"span_term":{"field":"value2"}}]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
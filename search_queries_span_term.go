@@ -0,0 +1,58 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// SpanTermQuery matches spans containing a term. It is the building
+// block of the other span queries, e.g. SpanNearQuery.
+// For more details, see
+// http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/query-dsl-span-term-query.html
+type SpanTermQuery struct {
+	field     string
+	value     interface{}
+	boost     *float32
+	queryName string
+}
+
+// NewSpanTermQuery creates a new span_term query.
+func NewSpanTermQuery(field string, value interface{}) SpanTermQuery {
+	return SpanTermQuery{field: field, value: value}
+}
+
+// Boost sets the boost for this query.
+func (q SpanTermQuery) Boost(boost float32) SpanTermQuery {
+	q.boost = &boost
+	return q
+}
+
+// QueryName sets the query name for the span_term query that can be used
+// when searching for matched_filters per hit.
+func (q SpanTermQuery) QueryName(queryName string) SpanTermQuery {
+	q.queryName = queryName
+	return q
+}
+
+// Source returns the query source for the span_term query.
+func (q SpanTermQuery) Source() interface{} {
+	// {"span_term":{"user":"kimchy"}}
+	// {"span_term":{"user":{"value":"kimchy","boost":2.0}}}
+	source := make(map[string]interface{})
+	tq := make(map[string]interface{})
+	source["span_term"] = tq
+
+	if q.boost == nil && q.queryName == "" {
+		tq[q.field] = q.value
+	} else {
+		subQ := make(map[string]interface{})
+		subQ["value"] = q.value
+		if q.boost != nil {
+			subQ["boost"] = *q.boost
+		}
+		if q.queryName != "" {
+			subQ["_name"] = q.queryName
+		}
+		tq[q.field] = subQ
+	}
+	return source
+}
@@ -15,23 +15,27 @@ type TermQuery struct {
 	queryName string
 }
 
-// Creates a new term query.
+// NewTermQuery creates a new term query. The value may be e.g.
+// a string, a number, or a bool.
 func NewTermQuery(name string, value interface{}) TermQuery {
 	t := TermQuery{name: name, value: value}
 	return t
 }
 
+// Boost sets the boost for this query.
 func (q TermQuery) Boost(boost float32) TermQuery {
 	q.boost = &boost
 	return q
 }
 
+// QueryName sets the query name for the term query that can be used
+// when searching for matched_filters per hit.
 func (q TermQuery) QueryName(queryName string) TermQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the term query.
+// Source returns the query source for the term query.
 func (q TermQuery) Source() interface{} {
 	// {"term":{"name":"value"}}
 	source := make(map[string]interface{})
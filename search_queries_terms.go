@@ -31,27 +31,33 @@ func NewTermsQuery(name string, values ...interface{}) TermsQuery {
 	return t
 }
 
+// MinimumShouldMatch specifies the number or percentage of terms
+// that must match.
 func (q TermsQuery) MinimumShouldMatch(minimumShouldMatch string) TermsQuery {
 	q.minimumShouldMatch = minimumShouldMatch
 	return q
 }
 
+// DisableCoord disables the coord factor in scoring.
 func (q TermsQuery) DisableCoord(disableCoord bool) TermsQuery {
 	q.disableCoord = &disableCoord
 	return q
 }
 
+// Boost sets the boost for this query.
 func (q TermsQuery) Boost(boost float32) TermsQuery {
 	q.boost = &boost
 	return q
 }
 
+// QueryName sets the query name for the terms query that can be used
+// when searching for matched_filters per hit.
 func (q TermsQuery) QueryName(queryName string) TermsQuery {
 	q.queryName = queryName
 	return q
 }
 
-// Creates the query source for the term query.
+// Source returns the query source for the terms query.
 func (q TermsQuery) Source() interface{} {
 	// {"terms":{"name":["value1","value2"]}}
 	source := make(map[string]interface{})
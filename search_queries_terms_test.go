@@ -36,3 +36,16 @@ func TestTermQuerysWithOptions(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestTermsQueryWithNumbers(t *testing.T) {
+	q := NewTermsQuery("retweets", 1, 2.5, 108)
+	data, err := json.Marshal(q.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"terms":{"retweets":[1,2.5,108]}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
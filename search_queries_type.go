@@ -0,0 +1,38 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+// TypeQuery matches documents of the provided document / mapping type,
+// e.g. to restrict a search to one type of an index shared by
+// several types.
+// Notice that the type query requires Elasticsearch 2.0 or later.
+// With earlier versions, use a TypeFilter instead.
+//
+// For details, see:
+// http://www.elastic.co/guide/en/elasticsearch/reference/current/query-dsl-type-query.html
+type TypeQuery struct {
+	Query
+	typ string
+}
+
+// NewTypeQuery creates a new type query.
+func NewTypeQuery(typ string) TypeQuery {
+	q := TypeQuery{typ: typ}
+	return q
+}
+
+// Source returns the query source for the type query.
+func (q TypeQuery) Source() interface{} {
+	// {
+	//   "type" : {
+	//     "value" : "..."
+	//   }
+	// }
+	source := make(map[string]interface{})
+	params := make(map[string]interface{})
+	source["type"] = params
+	params["value"] = q.typ
+	return source
+}
@@ -134,9 +134,9 @@ func (r *SearchRequest) header() interface{} {
 	switch len(r.types) {
 	case 0:
 	case 1:
-		h["types"] = r.types[0]
+		h["type"] = r.types[0]
 	default:
-		h["type"] = r.types
+		h["types"] = r.types
 	}
 
 	if r.routing != nil && *r.routing != "" {
@@ -150,9 +150,12 @@ func (r *SearchRequest) header() interface{} {
 	return h
 }
 
-// bidy is used by MultiSearch to get information about the search body
-// of one SearchRequest.
+// body is used by MultiSearch to get information about the search body
+// of one SearchRequest. It is an empty object if no source is set.
 // See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-multi-search.html
 func (r *SearchRequest) body() interface{} {
+	if r.source == nil {
+		return make(map[string]interface{})
+	}
 	return r.source
 }
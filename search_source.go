@@ -20,8 +20,10 @@ type SearchSource struct {
 	sorts                    []SortInfo
 	sorters                  []Sorter
 	trackScores              bool
+	searchAfterSortValues    []interface{}
 	minScore                 *float64
 	timeout                  string
+	terminateAfter           *int
 	fieldNames               []string
 	fieldDataFields          []string
 	scriptFields             []*ScriptField
@@ -65,7 +67,8 @@ func (s *SearchSource) Query(query Query) *SearchSource {
 }
 
 // PostFilter is executed as the last filter. It only affects the
-// search hits but not facets.
+// search hits but not facets or aggregations. A Query can be
+// passed as well.
 func (s *SearchSource) PostFilter(postFilter Filter) *SearchSource {
 	s.postFilter = postFilter
 	return s
@@ -81,6 +84,7 @@ func (s *SearchSource) Size(size int) *SearchSource {
 	return s
 }
 
+// MinScore excludes documents which have a score less than minScore.
 func (s *SearchSource) MinScore(minScore float64) *SearchSource {
 	s.minScore = &minScore
 	return s
@@ -96,6 +100,13 @@ func (s *SearchSource) Version(version bool) *SearchSource {
 	return s
 }
 
+// TerminateAfter specifies the maximum number of documents to collect for
+// each shard, upon reaching which the query execution will terminate early.
+func (s *SearchSource) TerminateAfter(terminateAfter int) *SearchSource {
+	s.terminateAfter = &terminateAfter
+	return s
+}
+
 func (s *SearchSource) Timeout(timeout string) *SearchSource {
 	s.timeout = timeout
 	return s
@@ -126,6 +137,15 @@ func (s *SearchSource) TrackScores(trackScores bool) *SearchSource {
 	return s
 }
 
+// SearchAfter returns the hits that follow the hit with the given sort
+// values. It must be combined with a deterministic sort, e.g. on a
+// unique field as a tie breaker. It replaces the sort values of
+// a previous call, so the same SearchSource can be used for every page.
+func (s *SearchSource) SearchAfter(sortValues ...interface{}) *SearchSource {
+	s.searchAfterSortValues = sortValues
+	return s
+}
+
 func (s *SearchSource) Facet(name string, facet Facet) *SearchSource {
 	s.facets[name] = facet
 	return s
@@ -163,6 +183,8 @@ func (s *SearchSource) Suggester(suggester Suggester) *SearchSource {
 	return s
 }
 
+// AddRescore adds a rescorer that recomputes the scores of the top hits.
+// Multiple rescorers are executed in the order they were added.
 func (s *SearchSource) AddRescore(rescore *Rescore) *SearchSource {
 	s.rescores = append(s.rescores, rescore)
 	return s
@@ -277,6 +299,9 @@ func (s *SearchSource) Source() interface{} {
 	if s.version != nil {
 		source["version"] = *s.version
 	}
+	if s.terminateAfter != nil {
+		source["terminate_after"] = *s.terminateAfter
+	}
 	if s.explain != nil {
 		source["explain"] = *s.explain
 	}
@@ -331,6 +356,10 @@ func (s *SearchSource) Source() interface{} {
 		source["track_scores"] = s.trackScores
 	}
 
+	if len(s.searchAfterSortValues) > 0 {
+		source["search_after"] = s.searchAfterSortValues
+	}
+
 	if len(s.indexBoosts) > 0 {
 		source["indices_boost"] = s.indexBoosts
 	}
@@ -378,7 +407,7 @@ func (s *SearchSource) Source() interface{} {
 		if len(rescores) == 1 {
 			rescores[0].defaultRescoreWindowSize = s.defaultRescoreWindowSize
 			source["rescore"] = rescores[0].Source()
-		} else {
+		} else if len(rescores) > 1 {
 			slice := make([]interface{}, 0)
 			for _, r := range rescores {
 				r.defaultRescoreWindowSize = s.defaultRescoreWindowSize
@@ -436,17 +465,24 @@ type ScriptField struct {
 	FieldName string
 
 	script string
+	typ    string
 	lang   string
 	params map[string]interface{}
 }
 
 func NewScriptField(fieldName, script, lang string, params map[string]interface{}) *ScriptField {
-	return &ScriptField{fieldName, script, lang, params}
+	return &ScriptField{fieldName, script, "", lang, params}
+}
+
+// NewScriptFieldFromScript creates a new ScriptField that returns the
+// value computed by the given script as fieldName in every hit.
+func NewScriptFieldFromScript(fieldName string, script *Script) *ScriptField {
+	return &ScriptField{fieldName, script.script, script.typ, script.lang, script.params}
 }
 
 func (f *ScriptField) Source() interface{} {
 	source := make(map[string]interface{})
-	source["script"] = f.script
+	source[scriptKey(f.typ)] = f.script
 	if f.lang != "" {
 		source["lang"] = f.lang
 	}
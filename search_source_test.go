@@ -23,6 +23,20 @@ func TestSearchSourceMatchAllQuery(t *testing.T) {
 	}
 }
 
+func TestSearchSourceFromAndSize(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).From(20).Size(10)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"from":20,"query":{"match_all":{}},"size":10}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSearchSourceNoFields(t *testing.T) {
 	matchAllQ := NewMatchAllQuery()
 	builder := NewSearchSource().Query(matchAllQ).NoFields()
@@ -80,6 +94,21 @@ func TestSearchSourceFetchSourceByWildcards(t *testing.T) {
 	}
 }
 
+func TestSearchSourceFetchSourceIncludes(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).
+		FetchSourceContext(NewFetchSourceContext(true).Include("user", "message"))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"_source":["user","message"],"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
 func TestSearchSourceFieldDataFields(t *testing.T) {
 	matchAllQ := NewMatchAllQuery()
 	builder := NewSearchSource().Query(matchAllQ).FieldDataFields("test1", "test2")
@@ -153,7 +182,7 @@ func TestSearchSourceRescoring(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"query":{"match_all":{}},"rescore":{"query":{"query_weight":0.7,"rescore_query":{"match":{"field1":{"query":"the quick brown fox","slop":2,"type":"phrase"}}},"rescore_query_weight":1.2},"window_size":50}}`
+	expected := `{"query":{"match_all":{}},"rescore":{"query":{"query_weight":0.7,"rescore_query":{"match_phrase":{"field1":{"query":"the quick brown fox","slop":2}}},"rescore_query_weight":1.2},"window_size":50}}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
@@ -183,7 +212,164 @@ func TestSearchSourceInnerHits(t *testing.T) {
 		t.Fatalf("marshaling to JSON failed: %v", err)
 	}
 	got := string(data)
-	expected := `{"inner_hits":{"comments":{"type":{"comment":{"query":{"match":{"user":{"query":"olivere"}}}}}},"views":{"path":{"view":{}}}},"query":{"match_all":{}}}`
+	expected := `{"inner_hits":{"comments":{"type":{"comment":{"query":{"match":{"user":"olivere"}}}}},"views":{"path":{"view":{}}}},"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceSuggesters(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	ts := NewTermSuggester("my-term-suggest").Field("body").Text("quikc").Size(3)
+	ps := NewPhraseSuggester("my-phrase-suggest").Field("body").Text("quikc broen").MaxErrors(2).GramSize(2)
+	builder := NewSearchSource().Query(matchAllQ).Suggester(ts).Suggester(ps)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"suggest":{"my-phrase-suggest":{"text":"quikc broen","phrase":{"field":"body","gram_size":2,"max_errors":2}},"my-term-suggest":{"text":"quikc","term":{"field":"body","size":3}}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceExplain(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).Explain(true)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"explain":true,"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceMinScore(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).MinScore(0.5)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"min_score":0.5,"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourcePostFilterWithQueryAndAggregation(t *testing.T) {
+	matchQ := NewMatchQuery("message", "golang")
+	agg := NewTermsAggregation().Field("user")
+	builder := NewSearchSource().Query(matchQ).
+		Aggregation("users", agg).
+		PostFilter(NewTermQuery("user", "olivere"))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"aggregations":{"users":{"terms":{"field":"user"}}},"post_filter":{"term":{"user":"olivere"}},"query":{"match":{"message":"golang"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceFieldsAndScriptFieldFromScript(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	script := NewScript("doc['price'].value * factor").Lang("groovy").Param("factor", 1.2)
+	builder := NewSearchSource().Query(matchAllQ).
+		Fields("user", "message").
+		ScriptField(NewScriptFieldFromScript("price_with_tax", script))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"fields":["user","message"],"query":{"match_all":{}},"script_fields":{"price_with_tax":{"lang":"groovy","params":{"factor":1.2},"script":"doc['price'].value * factor"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceScriptFieldFromStoredScript(t *testing.T) {
+	script := NewScriptStored("price-with-tax").Param("factor", 1.2)
+	builder := NewSearchSource().ScriptField(NewScriptFieldFromScript("price_with_tax", script))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"script_fields":{"price_with_tax":{"params":{"factor":1.2},"script_id":"price-with-tax"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceVersionAndTerminateAfter(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).Version(true).TerminateAfter(1)
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"terminate_after":1,"version":true}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceWithManyRescorers(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	phrase := NewQueryRescorer(NewMatchQuery("field1", "the quick brown fox").Type("phrase").Slop(2))
+	boost := NewQueryRescorer(NewTermQuery("featured", true)).ScoreMode("multiply")
+	builder := NewSearchSource().Query(matchAllQ).
+		AddRescore(NewRescore().WindowSize(100).Rescorer(phrase)).
+		AddRescore(NewRescore()).
+		AddRescore(NewRescore().WindowSize(10).Rescorer(boost))
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"rescore":[{"query":{"rescore_query":{"match_phrase":{"field1":{"query":"the quick brown fox","slop":2}}}},"window_size":100},{"query":{"rescore_query":{"term":{"featured":true}},"score_mode":"multiply"},"window_size":10}]}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceWithEmptyRescorer(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).AddRescore(NewRescore())
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestSearchSourceSearchAfter(t *testing.T) {
+	matchAllQ := NewMatchAllQuery()
+	builder := NewSearchSource().Query(matchAllQ).
+		Sort("created", false).
+		Sort("_uid", true).
+		SearchAfter(1463538850, "tweet#654320").
+		SearchAfter(1463538857, "tweet#654323")
+	data, err := json.Marshal(builder.Source())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"match_all":{}},"search_after":[1463538857,"tweet#654323"],"sort":[{"created":{"order":"desc"}},{"_uid":{"order":"asc"}}]}`
 	if got != expected {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
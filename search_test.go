@@ -6,7 +6,10 @@ package elastic
 
 import (
 	"encoding/json"
-	_ "net/http"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
 	"reflect"
 	"testing"
 	"time"
@@ -43,6 +46,31 @@ func TestSearchMatchAll(t *testing.T) {
 	}
 }
 
+func TestSearchWithTypesAndPagination(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	all := NewMatchAllQuery()
+	searchResult, err := client.Search().
+		Index(testIndexName).
+		Types("tweet", "comment").
+		Query(&all).
+		Sort("_uid", true).
+		From(1).Size(2).
+		Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searchResult.Hits == nil {
+		t.Fatalf("expected SearchResult.Hits != nil; got nil")
+	}
+	if searchResult.Hits.TotalHits != 4 {
+		t.Errorf("expected SearchResult.Hits.TotalHits = %d; got %d", 4, searchResult.Hits.TotalHits)
+	}
+	if len(searchResult.Hits.Hits) != 2 {
+		t.Errorf("expected len(SearchResult.Hits.Hits) = %d; got %d", 2, len(searchResult.Hits.Hits))
+	}
+}
+
 func BenchmarkSearchMatchAll(b *testing.B) {
 	client := setupTestClientAndCreateIndexAndAddDocs(b)
 
@@ -378,6 +406,62 @@ func TestSearchSpecificFields(t *testing.T) {
 	}
 }
 
+func TestSearchSourceFiltering(t *testing.T) {
+	client := setupTestClientAndCreateIndexAndAddDocs(t)
+
+	// Only return the user field of the _source
+	all := NewMatchAllQuery()
+	searchResult, err := client.Search().
+		Index(testIndexName).
+		Type("tweet").
+		Query(&all).
+		FetchSourceContext(NewFetchSourceContext(true).Include("user")).
+		Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searchResult.Hits == nil {
+		t.Fatal("expected SearchResult.Hits != nil; got nil")
+	}
+	if len(searchResult.Hits.Hits) != 3 {
+		t.Fatalf("expected len(SearchResult.Hits.Hits) = %d; got %d", 3, len(searchResult.Hits.Hits))
+	}
+	for _, hit := range searchResult.Hits.Hits {
+		if hit.Source == nil {
+			t.Fatal("expected SearchResult.Hits.Hit.Source to be != nil")
+		}
+		item := make(map[string]interface{})
+		if err := json.Unmarshal(*hit.Source, &item); err != nil {
+			t.Fatal(err)
+		}
+		if _, found := item["user"]; !found {
+			t.Errorf("expected field %q in _source; got: %v", "user", item)
+		}
+		if _, found := item["message"]; found {
+			t.Errorf("expected field %q not to be in _source; got: %v", "message", item)
+		}
+	}
+
+	// Don't return the _source at all
+	searchResult, err = client.Search().
+		Index(testIndexName).
+		Type("tweet").
+		Query(&all).
+		FetchSource(false).
+		Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if searchResult.Hits == nil {
+		t.Fatal("expected SearchResult.Hits != nil; got nil")
+	}
+	for _, hit := range searchResult.Hits.Hits {
+		if hit.Source != nil {
+			t.Errorf("expected SearchResult.Hits.Hit.Source to be nil; got: %q", *hit.Source)
+		}
+	}
+}
+
 func TestSearchExplain(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -880,3 +964,297 @@ func TestSearchInnerHitsOnHasParent(t *testing.T) {
 		t.Fatalf("expected inner hit with id %q; got: %q", "t3", innerHits.Hits.Hits[0].Id)
 	}
 }
+
+func TestSearchResultSuggest(t *testing.T) {
+	body := `{
+		"took": 5,
+		"hits": {"total": 0, "max_score": 0, "hits": []},
+		"suggest": {
+			"my-term-suggest": [
+				{"text": "quikc", "offset": 0, "length": 5, "options": [
+					{"text": "quick", "score": 0.8, "freq": 12}
+				]}
+			],
+			"my-phrase-suggest": [
+				{"text": "quikc broen", "offset": 0, "length": 11, "options": [
+					{"text": "quick brown", "highlighted": "<em>quick brown</em>", "score": 0.5}
+				]}
+			]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	termSuggestions, found := res.Suggest["my-term-suggest"]
+	if !found {
+		t.Fatalf("expected to find suggestion %q", "my-term-suggest")
+	}
+	if len(termSuggestions) != 1 {
+		t.Fatalf("expected %d suggestion; got: %d", 1, len(termSuggestions))
+	}
+	if len(termSuggestions[0].Options) != 1 {
+		t.Fatalf("expected %d option; got: %d", 1, len(termSuggestions[0].Options))
+	}
+	opt := termSuggestions[0].Options[0]
+	if opt.Text != "quick" {
+		t.Errorf("expected option text %q; got: %q", "quick", opt.Text)
+	}
+	if opt.Score != 0.8 {
+		t.Errorf("expected option score %v; got: %v", 0.8, opt.Score)
+	}
+	if opt.Freq != 12 {
+		t.Errorf("expected option freq %d; got: %d", 12, opt.Freq)
+	}
+
+	phraseSuggestions, found := res.Suggest["my-phrase-suggest"]
+	if !found {
+		t.Fatalf("expected to find suggestion %q", "my-phrase-suggest")
+	}
+	if len(phraseSuggestions) != 1 || len(phraseSuggestions[0].Options) != 1 {
+		t.Fatalf("expected 1 suggestion with 1 option; got: %v", phraseSuggestions)
+	}
+	opt = phraseSuggestions[0].Options[0]
+	if opt.Text != "quick brown" {
+		t.Errorf("expected option text %q; got: %q", "quick brown", opt.Text)
+	}
+	if opt.Highlighted != "<em>quick brown</em>" {
+		t.Errorf("expected highlighted option %q; got: %q", "<em>quick brown</em>", opt.Highlighted)
+	}
+}
+
+func TestSearchResultCompletionSuggest(t *testing.T) {
+	body := `{
+		"took": 2,
+		"hits": {"total": 0, "max_score": 0, "hits": []},
+		"suggest": {
+			"song-suggest": [
+				{"text": "ap", "offset": 0, "length": 2, "options": [
+					{"text": "Apple Pie", "score": 3.0, "payload": {"id": 17}},
+					{"text": "Apricot", "_index": "songs", "_type": "song", "_id": "42", "_score": 1.0, "_source": {"title": "Apricot"}}
+				]}
+			]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	suggestions := res.Suggest["song-suggest"]
+	if len(suggestions) != 1 || len(suggestions[0].Options) != 2 {
+		t.Fatalf("expected 1 suggestion with 2 options; got: %v", suggestions)
+	}
+
+	opt := suggestions[0].Options[0]
+	payload, ok := opt.Payload.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected payload to be a map; got: %T", opt.Payload)
+	}
+	if payload["id"] != float64(17) {
+		t.Errorf("expected payload id %v; got: %v", 17, payload["id"])
+	}
+
+	opt = suggestions[0].Options[1]
+	if opt.Index != "songs" || opt.Type != "song" || opt.Id != "42" {
+		t.Errorf("expected songs/song/42; got: %s/%s/%s", opt.Index, opt.Type, opt.Id)
+	}
+	if opt.Source == nil {
+		t.Fatalf("expected source; got: %v", opt.Source)
+	}
+	var song struct {
+		Title string `json:"title"`
+	}
+	if err := json.Unmarshal(*opt.Source, &song); err != nil {
+		t.Fatal(err)
+	}
+	if song.Title != "Apricot" {
+		t.Errorf("expected title %q; got: %q", "Apricot", song.Title)
+	}
+}
+
+func TestSearchResultHitFields(t *testing.T) {
+	body := `{
+		"took": 1,
+		"hits": {
+			"total": 1,
+			"max_score": 1.0,
+			"hits": [
+				{"_index": "twitter", "_type": "tweet", "_id": "1", "_score": 1.0,
+				 "fields": {"user": ["olivere"], "price_with_tax": [12.0]}}
+			]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Hits.Hits) != 1 {
+		t.Fatalf("expected %d hit; got: %d", 1, len(res.Hits.Hits))
+	}
+	fields := res.Hits.Hits[0].Fields
+	user, ok := fields["user"].([]interface{})
+	if !ok || len(user) != 1 || user[0] != "olivere" {
+		t.Errorf("expected field user = [olivere]; got: %v", fields["user"])
+	}
+	price, ok := fields["price_with_tax"].([]interface{})
+	if !ok || len(price) != 1 || price[0] != float64(12) {
+		t.Errorf("expected field price_with_tax = [12]; got: %v", fields["price_with_tax"])
+	}
+}
+
+func TestSearchResultVersionAndTerminatedEarly(t *testing.T) {
+	body := `{
+		"took": 1,
+		"timed_out": false,
+		"terminated_early": true,
+		"hits": {
+			"total": 1,
+			"max_score": 1.0,
+			"hits": [
+				{"_index": "twitter", "_type": "tweet", "_id": "1", "_version": 3, "_score": 1.0}
+			]
+		}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if !res.TerminatedEarly {
+		t.Errorf("expected TerminatedEarly = %v; got: %v", true, res.TerminatedEarly)
+	}
+	if len(res.Hits.Hits) != 1 {
+		t.Fatalf("expected %d hit; got: %d", 1, len(res.Hits.Hits))
+	}
+	hit := res.Hits.Hits[0]
+	if hit.Version == nil || *hit.Version != 3 {
+		t.Errorf("expected version %d; got: %v", 3, hit.Version)
+	}
+}
+
+func TestSearchResultLastSortValues(t *testing.T) {
+	var res SearchResult
+	if got := res.LastSortValues(); got != nil {
+		t.Errorf("expected no sort values without hits; got: %v", got)
+	}
+
+	body := `{
+		"took": 1,
+		"hits": {
+			"total": 2,
+			"hits": [
+				{"_index": "twitter", "_type": "tweet", "_id": "1", "sort": [1463538858, "tweet#1"]},
+				{"_index": "twitter", "_type": "tweet", "_id": "2", "sort": [1463538857, "tweet#2"]}
+			]
+		}
+	}`
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	got := res.LastSortValues()
+	expected := []interface{}{float64(1463538857), "tweet#2"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("expected sort values %v; got: %v", expected, got)
+	}
+}
+
+func TestSearchResultShardFailures(t *testing.T) {
+	body := `{
+		"took": 1,
+		"_shards": {
+			"total": 5,
+			"successful": 4,
+			"failed": 1,
+			"failures": [
+				{"index": "twitter", "shard": 2, "status": 400, "reason": "SearchParseException[...]"}
+			]
+		},
+		"hits": {"total": 0, "hits": []}
+	}`
+	var res SearchResult
+	if err := json.Unmarshal([]byte(body), &res); err != nil {
+		t.Fatal(err)
+	}
+	if res.Shards == nil {
+		t.Fatal("expected Shards != nil; got nil")
+	}
+	if res.Shards.Total != 5 || res.Shards.Successful != 4 || res.Shards.Failed != 1 {
+		t.Errorf("expected 5 total, 4 successful and 1 failed shards; got: %+v", res.Shards)
+	}
+	if len(res.Shards.Failures) != 1 {
+		t.Fatalf("expected %d shard failure; got: %d", 1, len(res.Shards.Failures))
+	}
+	failure := res.Shards.Failures[0]
+	if failure.Index != "twitter" || failure.Shard != 2 || failure.Status != 400 {
+		t.Errorf("expected failure on shard 2 of twitter with status 400; got: %+v", failure)
+	}
+	if failure.Reason != "SearchParseException[...]" {
+		t.Errorf("expected reason %q; got: %v", "SearchParseException[...]", failure.Reason)
+	}
+}
+
+func TestSearchFailOnShardFailures(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		fmt.Fprint(w, `{"took":1,"_shards":{"total":2,"successful":1,"failed":1,"failures":[{"index":"twitter","shard":1,"reason":{"type":"query_parsing_exception","reason":"failed"}}]},"hits":{"total":1,"hits":[{"_id":"1"}]}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Partial results are returned by default
+	res, err := client.Search("twitter").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Shards == nil || res.Shards.Failed != 1 {
+		t.Errorf("expected 1 failed shard; got: %+v", res.Shards)
+	}
+
+	res, err = client.Search("twitter").FailOnShardFailures(true).Do()
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if res != nil {
+		t.Errorf("expected no result; got: %v", res)
+	}
+	e, ok := err.(*ShardFailuresError)
+	if !ok {
+		t.Fatalf("expected *ShardFailuresError; got: %T", err)
+	}
+	if e.Total != 2 || e.Failed != 1 || len(e.Failures) != 1 {
+		t.Errorf("expected 1 of 2 shards to fail; got: %+v", e)
+	}
+}
+
+func TestSearchWithRoutingAndPreference(t *testing.T) {
+	var params url.Values
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/" {
+			w.WriteHeader(http.StatusOK)
+			return
+		}
+		params = r.URL.Query()
+		fmt.Fprint(w, `{"took":1,"hits":{"total":0,"hits":[]}}`)
+	}))
+	defer ts.Close()
+
+	client, err := NewClient(SetURL(ts.URL), SetSniff(false))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = client.Search("twitter").Routing("olivere").Preference("xyzabc123").Do()
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "preference=xyzabc123&routing=olivere"
+	if got := params.Encode(); got != expected {
+		t.Errorf("expected URL parameters\n%s\ngot:\n%s", expected, got)
+	}
+}
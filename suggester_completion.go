@@ -16,6 +16,7 @@ type CompletionSuggester struct {
 	size           *int
 	shardSize      *int
 	contextQueries []SuggesterContextQuery
+	fuzzy          *bool
 }
 
 // Creates a new completion suggester.
@@ -30,11 +31,13 @@ func (q CompletionSuggester) Name() string {
 	return q.name
 }
 
+// Text sets the prefix to complete, e.g. what the user typed so far.
 func (q CompletionSuggester) Text(text string) CompletionSuggester {
 	q.text = text
 	return q
 }
 
+// Field sets the completion field to fetch the suggestions from.
 func (q CompletionSuggester) Field(field string) CompletionSuggester {
 	q.field = field
 	return q
@@ -45,6 +48,7 @@ func (q CompletionSuggester) Analyzer(analyzer string) CompletionSuggester {
 	return q
 }
 
+// Size sets the number of suggestions to return (default: 5).
 func (q CompletionSuggester) Size(size int) CompletionSuggester {
 	q.size = &size
 	return q
@@ -65,6 +69,14 @@ func (q CompletionSuggester) ContextQueries(queries ...SuggesterContextQuery) Co
 	return q
 }
 
+// Fuzzy enables fuzzy completion with the default fuzzy options, so
+// that e.g. "aple" still suggests "apple". Use FuzzyCompletionSuggester
+// to control fuzziness, prefix length etc.
+func (q CompletionSuggester) Fuzzy(fuzzy bool) CompletionSuggester {
+	q.fuzzy = &fuzzy
+	return q
+}
+
 // completionSuggesterRequest is necessary because the order in which
 // the JSON elements are routed to Elasticsearch is relevant.
 // We got into trouble when using plain maps because the text element
@@ -109,7 +121,9 @@ func (q CompletionSuggester) Source(includeName bool) interface{} {
 		suggester["context"] = ctxq
 	}
 
-	// TODO(oe) Add competion-suggester specific parameters here
+	if q.fuzzy != nil {
+		suggester["fuzzy"] = *q.fuzzy
+	}
 
 	if !includeName {
 		return cs
@@ -23,3 +23,20 @@ func TestCompletionSuggesterSource(t *testing.T) {
 		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
 	}
 }
+
+func TestCompletionSuggesterSourceWithFuzzyAndSize(t *testing.T) {
+	s := NewCompletionSuggester("song-suggest").
+		Text("ap").
+		Field("suggest").
+		Size(10).
+		Fuzzy(true)
+	data, err := json.Marshal(s.Source(true))
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"song-suggest":{"text":"ap","completion":{"field":"suggest","fuzzy":true,"size":10}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
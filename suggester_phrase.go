@@ -4,6 +4,8 @@
 
 package elastic
 
+// PhraseSuggester suggests whole corrected phrases instead of
+// individual terms, e.g. for "did you mean" functionality.
 // For more details, see
 // http://www.elasticsearch.org/guide/reference/api/search/phrase-suggest/
 type PhraseSuggester struct {
@@ -47,11 +49,13 @@ func (q PhraseSuggester) Name() string {
 	return q.name
 }
 
+// Text sets the text to provide suggestions for.
 func (q PhraseSuggester) Text(text string) PhraseSuggester {
 	q.text = text
 	return q
 }
 
+// Field sets the field to fetch the candidate suggestions from.
 func (q PhraseSuggester) Field(field string) PhraseSuggester {
 	q.field = field
 	return q
@@ -62,6 +66,7 @@ func (q PhraseSuggester) Analyzer(analyzer string) PhraseSuggester {
 	return q
 }
 
+// Size sets the number of candidate phrases to return.
 func (q PhraseSuggester) Size(size int) PhraseSuggester {
 	q.size = &size
 	return q
@@ -82,6 +87,7 @@ func (q PhraseSuggester) ContextQueries(queries ...SuggesterContextQuery) Phrase
 	return q
 }
 
+// GramSize sets the max size of the n-grams (shingles) in the field.
 func (q PhraseSuggester) GramSize(gramSize int) PhraseSuggester {
 	if gramSize >= 1 {
 		q.gramSize = &gramSize
@@ -89,6 +95,8 @@ func (q PhraseSuggester) GramSize(gramSize int) PhraseSuggester {
 	return q
 }
 
+// MaxErrors sets the maximum number (if >= 1) or percentage (if < 1)
+// of terms that are considered to be misspellings.
 func (q PhraseSuggester) MaxErrors(maxErrors float32) PhraseSuggester {
 	q.maxErrors = &maxErrors
 	return q
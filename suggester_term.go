@@ -4,6 +4,8 @@
 
 package elastic
 
+// TermSuggester suggests terms based on edit distance, e.g. to
+// correct misspelled words in the provided text.
 // For more details, see
 // http://www.elasticsearch.org/guide/reference/api/search/term-suggest/
 type TermSuggester struct {
@@ -41,11 +43,13 @@ func (q TermSuggester) Name() string {
 	return q.name
 }
 
+// Text sets the text to provide suggestions for.
 func (q TermSuggester) Text(text string) TermSuggester {
 	q.text = text
 	return q
 }
 
+// Field sets the field to fetch the candidate suggestions from.
 func (q TermSuggester) Field(field string) TermSuggester {
 	q.field = field
 	return q
@@ -56,6 +60,7 @@ func (q TermSuggester) Analyzer(analyzer string) TermSuggester {
 	return q
 }
 
+// Size sets the maximum number of suggestions to return per token.
 func (q TermSuggester) Size(size int) TermSuggester {
 	q.size = &size
 	return q
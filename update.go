@@ -18,7 +18,7 @@ type UpdateResult struct {
 	Index     string     `json:"_index"`
 	Type      string     `json:"_type"`
 	Id        string     `json:"_id"`
-	Version   int        `json:"_version"`
+	Version   int64      `json:"_version"`
 	Created   bool       `json:"created"`
 	GetResult *GetResult `json:"get"`
 }
@@ -33,7 +33,7 @@ type UpdateService struct {
 	id               string
 	routing          string
 	parent           string
-	script           string
+	script           *Script
 	scriptId         string
 	scriptFile       string
 	scriptType       string
@@ -97,6 +97,14 @@ func (b *UpdateService) Parent(parent string) *UpdateService {
 
 // Script is the URL-encoded script definition.
 func (b *UpdateService) Script(script string) *UpdateService {
+	b.script = NewScript(script)
+	return b
+}
+
+// ScriptObject is the script that updates the document, e.g.
+// NewScript("ctx._source.counter += count").Param("count", 4).
+// It replaces a script set with Script.
+func (b *UpdateService) ScriptObject(script *Script) *UpdateService {
 	b.script = script
 	return b
 }
@@ -120,11 +128,14 @@ func (b *UpdateService) ScriptType(scriptType string) *UpdateService {
 }
 
 // ScriptLang defines the scripting language (default: groovy).
+// The language of ScriptObject takes precedence.
 func (b *UpdateService) ScriptLang(scriptLang string) *UpdateService {
 	b.scriptLang = scriptLang
 	return b
 }
 
+// ScriptParams sets the parameters of the script.
+// The parameters of ScriptObject take precedence.
 func (b *UpdateService) ScriptParams(params map[string]interface{}) *UpdateService {
 	b.scriptParams = params
 	return b
@@ -278,8 +289,15 @@ func (b *UpdateService) url() (string, url.Values, error) {
 func (b *UpdateService) body() (interface{}, error) {
 	source := make(map[string]interface{})
 
-	if b.script != "" {
-		source["script"] = b.script
+	lang, params := b.scriptLang, b.scriptParams
+	if b.script != nil {
+		source[scriptKey(b.script.typ)] = b.script.script
+		if b.script.lang != "" {
+			lang = b.script.lang
+		}
+		if len(b.script.params) > 0 {
+			params = b.script.params
+		}
 	}
 	if b.scriptId != "" {
 		source["script_id"] = b.scriptId
@@ -287,11 +305,11 @@ func (b *UpdateService) body() (interface{}, error) {
 	if b.scriptFile != "" {
 		source["script_file"] = b.scriptFile
 	}
-	if b.scriptLang != "" {
-		source["lang"] = b.scriptLang
+	if lang != "" {
+		source["lang"] = lang
 	}
-	if len(b.scriptParams) > 0 {
-		source["params"] = b.scriptParams
+	if len(params) > 0 {
+		source["params"] = params
 	}
 	if b.scriptedUpsert != nil {
 		source["scripted_upsert"] = *b.scriptedUpsert
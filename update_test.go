@@ -234,6 +234,39 @@ func TestUpdateViaDocAndUpsert(t *testing.T) {
 	}
 }
 
+func TestUpdateViaScriptWithRetryOnConflict(t *testing.T) {
+	update := NewUpdateService(nil).
+		Index("test").Type("type1").Id("1").
+		ScriptObject(NewScript("ctx._source.counter += count").Params(map[string]interface{}{"count": 4})).
+		ScriptParams(map[string]interface{}{"count": 1}).
+		RetryOnConflict(3)
+	path, params, err := update.url()
+	if err != nil {
+		t.Fatalf("expected to return URL, got: %v", err)
+	}
+	expectedPath := `/test/type1/1/_update`
+	if expectedPath != path {
+		t.Errorf("expected URL path\n%s\ngot:\n%s", expectedPath, path)
+	}
+	expectedParams := url.Values{"retry_on_conflict": []string{"3"}}
+	if expectedParams.Encode() != params.Encode() {
+		t.Errorf("expected URL parameters\n%s\ngot:\n%s", expectedParams.Encode(), params.Encode())
+	}
+	body, err := update.body()
+	if err != nil {
+		t.Fatalf("expected to return body, got: %v", err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("expected to marshal body as JSON, got: %v", err)
+	}
+	got := string(data)
+	expected := `{"params":{"count":4},"script":"ctx._source.counter += count"}`
+	if got != expected {
+		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
+	}
+}
+
 func TestUpdateViaScriptIntegration(t *testing.T) {
 	client := setupTestClientAndCreateIndex(t)
 
@@ -311,3 +344,22 @@ func TestUpdateViaScriptIntegration(t *testing.T) {
 		t.Errorf("expected Tweet.Retweets to be %d; got %d", tweet1.Retweets+increment, tweetGot.Retweets)
 	}
 }
+
+func TestUpdateViaStoredScript(t *testing.T) {
+	update := NewUpdateService(nil).
+		Index("test").Type("type1").Id("1").
+		ScriptObject(NewScriptStored("my_script").Param("tag", "blue"))
+	body, err := update.body()
+	if err != nil {
+		t.Fatalf("expected to return body, got: %v", err)
+	}
+	data, err := json.Marshal(body)
+	if err != nil {
+		t.Fatalf("expected to marshal body as JSON, got: %v", err)
+	}
+	got := string(data)
+	expected := `{"params":{"tag":"blue"},"script_id":"my_script"}`
+	if got != expected {
+		t.Errorf("expected\n%s\ngot:\n%s", expected, got)
+	}
+}
@@ -0,0 +1,230 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/olivere/elastic/uritemplates"
+)
+
+// ValidateService validates a potentially expensive query without
+// executing it. With Explain, Elasticsearch also returns an explanation
+// per index, i.e. the error if the query is invalid or the Lucene query
+// it is rewritten to if it is valid.
+//
+// See http://www.elasticsearch.org/guide/en/elasticsearch/reference/current/search-validate.html.
+type ValidateService struct {
+	client            *Client
+	pretty            bool
+	index             []string
+	typ               []string
+	query             Query
+	q                 string
+	explain           *bool
+	rewrite           *bool
+	allowNoIndices    *bool
+	expandWildcards   string
+	ignoreUnavailable *bool
+}
+
+// NewValidateService creates a new ValidateService.
+func NewValidateService(client *Client) *ValidateService {
+	return &ValidateService{
+		client: client,
+		index:  make([]string, 0),
+		typ:    make([]string, 0),
+	}
+}
+
+// Index is a list of index names to restrict the operation to.
+// Omit it to validate the query against all indices.
+func (s *ValidateService) Index(index ...string) *ValidateService {
+	s.index = append(s.index, index...)
+	return s
+}
+
+// Type is a list of document types to restrict the operation to.
+func (s *ValidateService) Type(typ ...string) *ValidateService {
+	s.typ = append(s.typ, typ...)
+	return s
+}
+
+// Query sets the query to validate.
+func (s *ValidateService) Query(query Query) *ValidateService {
+	s.query = query
+	return s
+}
+
+// Q is a query in the Lucene query string syntax to validate.
+// It is an alternative to Query.
+func (s *ValidateService) Q(q string) *ValidateService {
+	s.q = q
+	return s
+}
+
+// Explain indicates whether to return detailed information about the
+// query per index, e.g. why it is invalid.
+func (s *ValidateService) Explain(explain bool) *ValidateService {
+	s.explain = &explain
+	return s
+}
+
+// Rewrite indicates whether the explanation should contain the actual
+// Lucene query that the query is rewritten to, e.g. with all terms of
+// a fuzzy or prefix query expanded.
+func (s *ValidateService) Rewrite(rewrite bool) *ValidateService {
+	s.rewrite = &rewrite
+	return s
+}
+
+// AllowNoIndices indicates whether to ignore if a wildcard indices
+// expression resolves into no concrete indices. (This includes `_all`
+// string or when no indices have been specified).
+func (s *ValidateService) AllowNoIndices(allowNoIndices bool) *ValidateService {
+	s.allowNoIndices = &allowNoIndices
+	return s
+}
+
+// ExpandWildcards specifies whether to expand wildcard expression to
+// concrete indices that are open, closed or both.
+func (s *ValidateService) ExpandWildcards(expandWildcards string) *ValidateService {
+	s.expandWildcards = expandWildcards
+	return s
+}
+
+// IgnoreUnavailable specifies whether specified concrete indices should be
+// ignored when unavailable (missing or closed).
+func (s *ValidateService) IgnoreUnavailable(ignoreUnavailable bool) *ValidateService {
+	s.ignoreUnavailable = &ignoreUnavailable
+	return s
+}
+
+// Pretty indicates that the JSON response be indented and human readable.
+func (s *ValidateService) Pretty(pretty bool) *ValidateService {
+	s.pretty = pretty
+	return s
+}
+
+// buildURL builds the URL for the operation.
+func (s *ValidateService) buildURL() (string, url.Values, error) {
+	var err error
+	var path string
+
+	if len(s.index) > 0 && len(s.typ) > 0 {
+		path, err = uritemplates.Expand("/{index}/{type}/_validate/query", map[string]string{
+			"index": strings.Join(s.index, ","),
+			"type":  strings.Join(s.typ, ","),
+		})
+	} else if len(s.index) > 0 {
+		path, err = uritemplates.Expand("/{index}/_validate/query", map[string]string{
+			"index": strings.Join(s.index, ","),
+		})
+	} else {
+		path = "/_validate/query"
+	}
+	if err != nil {
+		return "", url.Values{}, err
+	}
+
+	// Add query string parameters
+	params := url.Values{}
+	if s.pretty {
+		params.Set("pretty", "1")
+	}
+	if s.q != "" {
+		params.Set("q", s.q)
+	}
+	if s.explain != nil {
+		params.Set("explain", fmt.Sprintf("%v", *s.explain))
+	}
+	if s.rewrite != nil {
+		params.Set("rewrite", fmt.Sprintf("%v", *s.rewrite))
+	}
+	if s.allowNoIndices != nil {
+		params.Set("allow_no_indices", fmt.Sprintf("%v", *s.allowNoIndices))
+	}
+	if s.expandWildcards != "" {
+		params.Set("expand_wildcards", s.expandWildcards)
+	}
+	if s.ignoreUnavailable != nil {
+		params.Set("ignore_unavailable", fmt.Sprintf("%v", *s.ignoreUnavailable))
+	}
+	return path, params, nil
+}
+
+// body returns the request body of the operation, or nil if
+// no query has been specified.
+func (s *ValidateService) body() interface{} {
+	if s.query == nil {
+		return nil
+	}
+	body := make(map[string]interface{})
+	body["query"] = s.query.Source()
+	return body
+}
+
+// Validate checks if the operation is valid.
+func (s *ValidateService) Validate() error {
+	var invalid []string
+	if len(s.typ) > 0 && len(s.index) == 0 {
+		invalid = append(invalid, "Index")
+	}
+	if len(invalid) > 0 {
+		return fmt.Errorf("missing required fields: %v", invalid)
+	}
+	return nil
+}
+
+// Do executes the operation.
+func (s *ValidateService) Do() (*ValidateResponse, error) {
+	// Check pre-conditions
+	if err := s.Validate(); err != nil {
+		return nil, err
+	}
+
+	// Get URL for request
+	path, params, err := s.buildURL()
+	if err != nil {
+		return nil, err
+	}
+
+	// Get HTTP response
+	method := "GET"
+	body := s.body()
+	if body != nil {
+		method = "POST"
+	}
+	res, err := s.client.PerformRequest(method, path, params, body)
+	if err != nil {
+		return nil, err
+	}
+
+	// Return operation response
+	ret := new(ValidateResponse)
+	if err := json.Unmarshal(res.Body, ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// ValidateResponse is the response of ValidateService.Do.
+type ValidateResponse struct {
+	Valid        bool                   `json:"valid"`
+	Shards       *ShardsInfo            `json:"_shards,omitempty"`
+	Explanations []*ValidateExplanation `json:"explanations,omitempty"`
+}
+
+// ValidateExplanation is the explanation of the query for an index.
+// Error is set if the query is invalid, Explanation if it is valid.
+type ValidateExplanation struct {
+	Index       string `json:"index"`
+	Valid       bool   `json:"valid"`
+	Error       string `json:"error,omitempty"`
+	Explanation string `json:"explanation,omitempty"`
+}
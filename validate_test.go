@@ -0,0 +1,101 @@
+// Copyright 2012-2015 Oliver Eilhard. All rights reserved.
+// Use of this source code is governed by a MIT-license.
+// See http://olivere.mit-license.org/license.txt for details.
+
+package elastic
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateBuildURL(t *testing.T) {
+	tests := []struct {
+		Service        *ValidateService
+		ExpectedPath   string
+		ExpectedParams string
+	}{
+		{
+			NewValidateService(nil),
+			"/_validate/query",
+			"",
+		},
+		{
+			NewValidateService(nil).Index("twitter").Explain(true),
+			"/twitter/_validate/query",
+			"explain=true",
+		},
+		{
+			NewValidateService(nil).Index("twitter", "facebook").Type("tweet").Q("user:olivere").Rewrite(true),
+			"/twitter%2Cfacebook/tweet/_validate/query",
+			"q=user%3Aolivere&rewrite=true",
+		},
+	}
+
+	for _, test := range tests {
+		gotPath, gotParams, err := test.Service.buildURL()
+		if err != nil {
+			t.Fatalf("expected no error; got: %v", err)
+		}
+		if gotPath != test.ExpectedPath {
+			t.Errorf("expected URL path = %q; got: %q", test.ExpectedPath, gotPath)
+		}
+		if gotParams.Encode() != test.ExpectedParams {
+			t.Errorf("expected URL params = %q; got: %q", test.ExpectedParams, gotParams.Encode())
+		}
+	}
+}
+
+func TestValidateBody(t *testing.T) {
+	s := NewValidateService(nil).Index("twitter")
+	if body := s.body(); body != nil {
+		t.Errorf("expected no body without query; got: %v", body)
+	}
+	s = s.Query(NewTermQuery("user", "olivere"))
+	data, err := json.Marshal(s.body())
+	if err != nil {
+		t.Fatalf("marshaling to JSON failed: %v", err)
+	}
+	got := string(data)
+	expected := `{"query":{"term":{"user":"olivere"}}}`
+	if got != expected {
+		t.Errorf("expected\n%s\n,got:\n%s", expected, got)
+	}
+}
+
+func TestValidateValidate(t *testing.T) {
+	if err := NewValidateService(nil).Type("tweet").Validate(); err == nil {
+		t.Errorf("expected Validate to fail with a type but no index")
+	}
+	if err := NewValidateService(nil).Index("twitter").Type("tweet").Validate(); err != nil {
+		t.Errorf("expected Validate to succeed; got: %v", err)
+	}
+}
+
+func TestValidateResponse(t *testing.T) {
+	body := `{
+		"valid": false,
+		"_shards": {"total": 1, "successful": 1, "failed": 0},
+		"explanations": [{
+			"index": "twitter",
+			"valid": false,
+			"error": "QueryParsingException[[twitter] No query registered for [wrong]]"
+		}]
+	}`
+	var resp ValidateResponse
+	if err := json.Unmarshal([]byte(body), &resp); err != nil {
+		t.Fatal(err)
+	}
+	if resp.Valid {
+		t.Errorf("expected query to be invalid")
+	}
+	if len(resp.Explanations) != 1 {
+		t.Fatalf("expected %d explanation; got: %d", 1, len(resp.Explanations))
+	}
+	if resp.Explanations[0].Index != "twitter" {
+		t.Errorf("expected index %q; got: %q", "twitter", resp.Explanations[0].Index)
+	}
+	if resp.Explanations[0].Error == "" {
+		t.Errorf("expected an error in the explanation")
+	}
+}